@@ -474,10 +474,9 @@ func (c *CommandLine) databaseExists(db string) bool {
 				for _, row := range result.Series {
 					if row.Name == "databases" {
 						for _, values := range row.Values {
-							for _, database := range values {
-								if database == db {
-									return true
-								}
+							// Only the first column holds the database name.
+							if len(values) > 0 && values[0] == db {
+								return true
 							}
 						}
 					}
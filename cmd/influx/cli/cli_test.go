@@ -450,6 +450,37 @@ func TestParseCommand_Use(t *testing.T) {
 	}
 }
 
+func TestParseCommand_UseNotExist(t *testing.T) {
+	t.Parallel()
+	ts := emptyTestServer()
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	config := client.Config{URL: *u}
+	c, err := client.NewClient(config)
+	if err != nil {
+		t.Fatalf("unexpected error.  expected %v, actual %v", nil, err)
+	}
+
+	// Only database names match, not the other columns of SHOW DATABASES.
+	tests := []string{
+		"use nodb",
+		`use "owner=alice"`,
+		`use ""`,
+	}
+
+	for _, cmd := range tests {
+		m := cli.CommandLine{Client: c, Database: "db"}
+		if err := m.ParseCommand(cmd); err != nil {
+			t.Fatalf(`Got error %v for command %q, expected nil.`, err, cmd)
+		}
+
+		if m.Database != "db" {
+			t.Fatalf(`Command %q changed database to %q. Expected db`, cmd, m.Database)
+		}
+	}
+}
+
 func TestParseCommand_UseAuth(t *testing.T) {
 	t.Parallel()
 	ts := emptyTestServer()
@@ -659,7 +690,7 @@ func emptyTestServer() *httptest.Server {
 			switch stmt.(type) {
 			case *influxql.ShowDatabasesStatement:
 				if authorized {
-					io.WriteString(w, `{"results":[{"series":[{"name":"databases","columns":["name"],"values":[["db", "db db"]]}]}]}`)
+					io.WriteString(w, `{"results":[{"series":[{"name":"databases","columns":["name","labels"],"values":[["db",""],["db db",""],["labelled","owner=alice"]]}]}]}`)
 				} else {
 					w.WriteHeader(http.StatusUnauthorized)
 					io.WriteString(w, fmt.Sprintf(`{"error":"error authorizing query: %s not authorized to execute statement 'SHOW DATABASES', requires admin privilege"}`, user))
@@ -0,0 +1,308 @@
+// Package dumpmeta inspects the contents of a meta store.
+package dumpmeta
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/influxdata/influxdb/services/meta"
+)
+
+// Command represents the program execution for "influx_inspect dumpmeta".
+type Command struct {
+	// Standard input/output, overridden for testing.
+	Stderr io.Writer
+	Stdout io.Writer
+
+	dir      string
+	database string
+	json     bool
+}
+
+// NewCommand returns a new instance of Command.
+func NewCommand() *Command {
+	return &Command{
+		Stderr: os.Stderr,
+		Stdout: os.Stdout,
+	}
+}
+
+// Run executes the command.
+func (cmd *Command) Run(args ...string) error {
+	fs := flag.NewFlagSet("dumpmeta", flag.ExitOnError)
+	fs.StringVar(&cmd.dir, "dir", os.Getenv("HOME")+"/.influxdb/meta", "Meta storage path")
+	fs.StringVar(&cmd.database, "database", "", "Optional: only dump the given database")
+	fs.BoolVar(&cmd.json, "json", false, "Output as JSON")
+
+	fs.SetOutput(cmd.Stdout)
+	fs.Usage = func() {
+		fmt.Fprintf(cmd.Stdout, "Dumps the databases, retention policies, shard groups and users in a meta store.\n\n")
+		fmt.Fprintf(cmd.Stdout, "Usage: %s dumpmeta [flags]\n\n", filepath.Base(os.Args[0]))
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(filepath.Join(cmd.dir, "meta.db")); err != nil {
+		return err
+	}
+
+	// Open the meta store read-only so it is never modified.
+	config := meta.NewConfig()
+	config.Dir = cmd.dir
+	client := meta.NewClient(config, meta.ReadOnly())
+	if err := client.Open(); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	dump := newDump(client.Data(), cmd.database)
+	if cmd.database != "" && len(dump.Databases) == 0 {
+		return fmt.Errorf("database not found: %s", cmd.database)
+	}
+
+	if cmd.json {
+		enc := json.NewEncoder(cmd.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(dump)
+	}
+	return dump.write(cmd.Stdout)
+}
+
+// Dump is the printable contents of a meta store. Password hashes are omitted.
+type Dump struct {
+	ClusterID uint64     `json:"cluster_id"`
+	Index     uint64     `json:"index"`
+	Databases []Database `json:"databases"`
+	Users     []User     `json:"users,omitempty"`
+}
+
+// Database describes a database in the dump.
+type Database struct {
+	Name                   string            `json:"name"`
+	DefaultRetentionPolicy string            `json:"default_retention_policy"`
+	RetentionPolicies      []RetentionPolicy `json:"retention_policies"`
+	ContinuousQueries      []ContinuousQuery `json:"continuous_queries,omitempty"`
+	Labels                 map[string]string `json:"labels,omitempty"`
+}
+
+// RetentionPolicy describes a retention policy in the dump.
+type RetentionPolicy struct {
+	Name               string            `json:"name"`
+	Duration           string            `json:"duration"`
+	ShardGroupDuration string            `json:"shard_group_duration"`
+	ReplicaN           int               `json:"replica_n"`
+	ShardGroups        []ShardGroup      `json:"shard_groups,omitempty"`
+	Subscriptions      []Subscription    `json:"subscriptions,omitempty"`
+	Labels             map[string]string `json:"labels,omitempty"`
+}
+
+// ShardGroup describes a shard group in the dump.
+type ShardGroup struct {
+	ID          uint64     `json:"id"`
+	StartTime   time.Time  `json:"start_time"`
+	EndTime     time.Time  `json:"end_time"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	TruncatedAt *time.Time `json:"truncated_at,omitempty"`
+	Shards      []uint64   `json:"shards"`
+}
+
+// ContinuousQuery describes a continuous query in the dump.
+type ContinuousQuery struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+}
+
+// Subscription describes a subscription in the dump.
+type Subscription struct {
+	Name         string   `json:"name"`
+	Mode         string   `json:"mode"`
+	Destinations []string `json:"destinations"`
+}
+
+// User describes a user in the dump.
+type User struct {
+	Name       string            `json:"name"`
+	Admin      bool              `json:"admin"`
+	Privileges map[string]string `json:"privileges,omitempty"`
+}
+
+// newDump builds a Dump from data. If database is set, only that database
+// is included and users are omitted.
+func newDump(data meta.Data, database string) *Dump {
+	d := &Dump{
+		ClusterID: data.ClusterID,
+		Index:     data.Index,
+		Databases: []Database{},
+	}
+
+	for _, di := range data.Databases {
+		if database != "" && di.Name != database {
+			continue
+		}
+
+		db := Database{
+			Name:                   di.Name,
+			DefaultRetentionPolicy: di.DefaultRetentionPolicy,
+			RetentionPolicies:      []RetentionPolicy{},
+			Labels:                 di.Labels,
+		}
+		for _, rpi := range di.RetentionPolicies {
+			rp := RetentionPolicy{
+				Name:               rpi.Name,
+				Duration:           formatDuration(rpi.Duration),
+				ShardGroupDuration: formatDuration(rpi.ShardGroupDuration),
+				ReplicaN:           rpi.ReplicaN,
+				Labels:             rpi.Labels,
+			}
+			for _, sgi := range rpi.ShardGroups {
+				sg := ShardGroup{
+					ID:        sgi.ID,
+					StartTime: sgi.StartTime.UTC(),
+					EndTime:   sgi.EndTime.UTC(),
+					Shards:    make([]uint64, 0, len(sgi.Shards)),
+				}
+				if sgi.Deleted() {
+					t := sgi.DeletedAt.UTC()
+					sg.DeletedAt = &t
+				}
+				if sgi.Truncated() {
+					t := sgi.TruncatedAt.UTC()
+					sg.TruncatedAt = &t
+				}
+				for _, si := range sgi.Shards {
+					sg.Shards = append(sg.Shards, si.ID)
+				}
+				rp.ShardGroups = append(rp.ShardGroups, sg)
+			}
+			for _, si := range rpi.Subscriptions {
+				rp.Subscriptions = append(rp.Subscriptions, Subscription{
+					Name:         si.Name,
+					Mode:         si.Mode,
+					Destinations: si.Destinations,
+				})
+			}
+			db.RetentionPolicies = append(db.RetentionPolicies, rp)
+		}
+		for _, cqi := range di.ContinuousQueries {
+			db.ContinuousQueries = append(db.ContinuousQueries, ContinuousQuery{Name: cqi.Name, Query: cqi.Query})
+		}
+		d.Databases = append(d.Databases, db)
+	}
+
+	if database != "" {
+		return d
+	}
+
+	for _, ui := range data.Users {
+		u := User{Name: ui.Name, Admin: ui.Admin}
+		if len(ui.Privileges) > 0 {
+			u.Privileges = make(map[string]string, len(ui.Privileges))
+			for db, p := range ui.Privileges {
+				u.Privileges[db] = p.String()
+			}
+		}
+		d.Users = append(d.Users, u)
+	}
+	return d
+}
+
+// write prints d in a readable form to w.
+func (d *Dump) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Cluster ID:\t%d\n", d.ClusterID)
+	fmt.Fprintf(tw, "Index:\t%d\n", d.Index)
+
+	for _, db := range d.Databases {
+		fmt.Fprintf(tw, "\nDatabase: %s\n", db.Name)
+		if len(db.Labels) > 0 {
+			fmt.Fprintf(tw, "Labels: %s\n", formatPairs(db.Labels))
+		}
+
+		fmt.Fprintln(tw, "  Retention Policy\tDuration\tShard Group Duration\tReplicaN\tDefault\tLabels")
+		for _, rp := range db.RetentionPolicies {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%d\t%t\t%s\n", rp.Name, rp.Duration, rp.ShardGroupDuration, rp.ReplicaN, rp.Name == db.DefaultRetentionPolicy, formatPairs(rp.Labels))
+		}
+
+		for _, rp := range db.RetentionPolicies {
+			if len(rp.ShardGroups) == 0 {
+				continue
+			}
+			fmt.Fprintf(tw, "\n  Shard Groups (%s)\tStart\tEnd\tShards\tStatus\n", rp.Name)
+			for _, sg := range rp.ShardGroups {
+				status := "active"
+				if sg.DeletedAt != nil {
+					status = "deleted " + sg.DeletedAt.Format(time.RFC3339)
+				} else if sg.TruncatedAt != nil {
+					status = "truncated " + sg.TruncatedAt.Format(time.RFC3339)
+				}
+				fmt.Fprintf(tw, "  %d\t%s\t%s\t%s\t%s\n", sg.ID,
+					sg.StartTime.Format(time.RFC3339), sg.EndTime.Format(time.RFC3339),
+					formatIDs(sg.Shards), status)
+			}
+		}
+
+		for _, rp := range db.RetentionPolicies {
+			if len(rp.Subscriptions) == 0 {
+				continue
+			}
+			fmt.Fprintf(tw, "\n  Subscriptions (%s)\tMode\tDestinations\n", rp.Name)
+			for _, s := range rp.Subscriptions {
+				fmt.Fprintf(tw, "  %s\t%s\t%s\n", s.Name, s.Mode, strings.Join(s.Destinations, ","))
+			}
+		}
+
+		if len(db.ContinuousQueries) > 0 {
+			fmt.Fprintln(tw, "\n  Continuous Query\tQuery")
+			for _, cq := range db.ContinuousQueries {
+				fmt.Fprintf(tw, "  %s\t%s\n", cq.Name, cq.Query)
+			}
+		}
+	}
+
+	if len(d.Users) > 0 {
+		fmt.Fprintln(tw, "\nUser\tAdmin\tPrivileges")
+		for _, u := range d.Users {
+			fmt.Fprintf(tw, "%s\t%t\t%s\n", u.Name, u.Admin, formatPairs(u.Privileges))
+		}
+	}
+
+	return tw.Flush()
+}
+
+// formatDuration returns d as a string, using "INF" for an infinite duration.
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "INF"
+	}
+	return d.String()
+}
+
+func formatIDs(ids []uint64) string {
+	a := make([]string, len(ids))
+	for i, id := range ids {
+		a[i] = fmt.Sprint(id)
+	}
+	return strings.Join(a, ",")
+}
+
+// formatPairs returns m as a comma-separated list of key=value pairs, sorted by key.
+func formatPairs(m map[string]string) string {
+	a := make([]string, 0, len(m))
+	for k, v := range m {
+		a = append(a, k+"="+v)
+	}
+	sort.Strings(a)
+	return strings.Join(a, ",")
+}
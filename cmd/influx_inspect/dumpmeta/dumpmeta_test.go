@@ -0,0 +1,115 @@
+package dumpmeta_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/cmd/influx_inspect/dumpmeta"
+	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxql"
+)
+
+func TestCommand_Run(t *testing.T) {
+	dir := mustCreateMeta(t)
+	defer os.RemoveAll(dir)
+
+	var buf bytes.Buffer
+	cmd := dumpmeta.NewCommand()
+	cmd.Stdout = &buf
+	if err := cmd.Run("-dir", dir); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	for _, s := range []string{
+		"Database: db0",
+		"autogen",
+		"Subscriptions (autogen)",
+		"sub0",
+		"cq0",
+		"alice",
+		"db0=READ",
+		"Labels: owner=alice,team=ops",
+		"purpose=raw",
+	} {
+		if !strings.Contains(out, s) {
+			t.Fatalf("output does not contain %q:\n%s", s, out)
+		}
+	}
+	if strings.Contains(out, "$2a$") {
+		t.Fatalf("output contains a password hash:\n%s", out)
+	}
+}
+
+func TestCommand_Run_JSON(t *testing.T) {
+	dir := mustCreateMeta(t)
+	defer os.RemoveAll(dir)
+
+	var buf bytes.Buffer
+	cmd := dumpmeta.NewCommand()
+	cmd.Stdout = &buf
+	if err := cmd.Run("-dir", dir, "-json", "-database", "db0"); err != nil {
+		t.Fatal(err)
+	}
+
+	var dump dumpmeta.Dump
+	if err := json.Unmarshal(buf.Bytes(), &dump); err != nil {
+		t.Fatal(err)
+	}
+	if len(dump.Databases) != 1 || dump.Databases[0].Name != "db0" {
+		t.Fatalf("unexpected databases: %+v", dump.Databases)
+	} else if rps := dump.Databases[0].RetentionPolicies; len(rps) != 1 || len(rps[0].ShardGroups) != 1 {
+		t.Fatalf("unexpected retention policies: %+v", rps)
+	} else if exp := map[string]string{"owner": "alice", "team": "ops"}; !reflect.DeepEqual(dump.Databases[0].Labels, exp) {
+		t.Fatalf("unexpected database labels: %v", dump.Databases[0].Labels)
+	} else if exp := map[string]string{"purpose": "raw"}; !reflect.DeepEqual(rps[0].Labels, exp) {
+		t.Fatalf("unexpected retention policy labels: %v", rps[0].Labels)
+	} else if len(dump.Users) != 0 {
+		t.Fatalf("unexpected users: %+v", dump.Users)
+	}
+
+	if err := cmd.Run("-dir", dir, "-database", "db1"); err == nil {
+		t.Fatal("expected error for unknown database")
+	}
+}
+
+// mustCreateMeta returns a meta directory with some test data in it.
+func mustCreateMeta(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "dumpmeta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := meta.NewConfig()
+	config.Dir = dir
+	c := meta.NewClient(config)
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	} else if _, err := c.CreateShardGroup("db0", "autogen", time.Now()); err != nil {
+		t.Fatal(err)
+	} else if err := c.CreateSubscription("db0", "autogen", "sub0", "ANY", []string{"udp://localhost:9090"}); err != nil {
+		t.Fatal(err)
+	} else if err := c.CreateContinuousQuery("db0", "cq0", `CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT count(value) INTO foo_count FROM foo GROUP BY time(10m) END`); err != nil {
+		t.Fatal(err)
+	} else if _, err := c.CreateUser("alice", "pass", false); err != nil {
+		t.Fatal(err)
+	} else if err := c.SetPrivilege("alice", "db0", influxql.ReadPrivilege); err != nil {
+		t.Fatal(err)
+	} else if err := c.SetDatabaseLabels("db0", map[string]string{"owner": "alice", "team": "ops"}); err != nil {
+		t.Fatal(err)
+	} else if err := c.SetRetentionPolicyLabels("db0", "autogen", map[string]string{"purpose": "raw"}); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
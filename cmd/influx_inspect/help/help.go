@@ -31,6 +31,7 @@ Usage: influx_inspect [[command] [arguments]]
 
 The commands are:
 
+    dumpmeta             dumps the contents of a meta store.
     dumptsi              dumps low-level details about tsi1 files.
     dumptsm              dumps low-level details about tsm1 files.
     export               exports raw data from a shard to line protocol
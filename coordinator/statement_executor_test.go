@@ -221,7 +221,7 @@ func TestQueryExecutor_ExecuteQuery_ShowDatabases(t *testing.T) {
 		MetaClient: &internal.MetaClientMock{
 			DatabasesFn: func() []meta.DatabaseInfo {
 				return []meta.DatabaseInfo{
-					{Name: "db1"}, {Name: "db2"}, {Name: "db3"}, {Name: "db4"},
+					{Name: "db1"}, {Name: "db2", Labels: map[string]string{"team": "ops", "owner": "alice"}}, {Name: "db3"}, {Name: "db4"},
 				}
 			},
 		},
@@ -246,9 +246,9 @@ func TestQueryExecutor_ExecuteQuery_ShowDatabases(t *testing.T) {
 			StatementID: 0,
 			Series: []*models.Row{{
 				Name:    "databases",
-				Columns: []string{"name"},
+				Columns: []string{"name", "labels"},
 				Values: [][]interface{}{
-					{"db2"}, {"db4"},
+					{"db2", "owner=alice,team=ops"}, {"db4", ""},
 				},
 			}},
 		},
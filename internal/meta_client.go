@@ -10,6 +10,7 @@ import (
 // MetaClientMock is a mockable implementation of meta.MetaClient.
 type MetaClientMock struct {
 	CloseFn                             func() error
+	ClusterIDFn                         func() uint64
 	CreateContinuousQueryFn             func(database, name, query string) error
 	CreateDatabaseFn                    func(name string) (*meta.DatabaseInfo, error)
 	CreateDatabaseWithRetentionPolicyFn func(name string, spec *meta.RetentionPolicySpec) (*meta.DatabaseInfo, error)
@@ -57,6 +58,10 @@ func (c *MetaClientMock) Close() error {
 	return c.CloseFn()
 }
 
+func (c *MetaClientMock) ClusterID() uint64 {
+	return c.ClusterIDFn()
+}
+
 func (c *MetaClientMock) CreateContinuousQuery(database, name, query string) error {
 	return c.CreateContinuousQueryFn(database, name, query)
 }
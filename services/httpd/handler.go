@@ -87,6 +87,7 @@ type Handler struct {
 		Authenticate(username, password string) (ui meta.User, err error)
 		User(username string) (meta.User, error)
 		AdminUserExists() bool
+		ClusterID() uint64
 	}
 
 	QueryAuthorizer interface {
@@ -778,6 +779,7 @@ func (h *Handler) serveOptions(w http.ResponseWriter, r *http.Request) {
 // servePing returns a simple response to let the client know the server is running.
 func (h *Handler) servePing(w http.ResponseWriter, r *http.Request) {
 	atomic.AddInt64(&h.stats.PingRequests, 1)
+	w.Header().Set("X-Influxdb-Cluster-Id", strconv.FormatUint(h.MetaClient.ClusterID(), 10))
 	h.writeHeader(w, http.StatusNoContent)
 }
 
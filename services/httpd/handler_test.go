@@ -675,6 +675,20 @@ func TestHandler_Ping(t *testing.T) {
 	}
 }
 
+// Ensure the handler returns the cluster ID in ping responses.
+func TestHandler_Ping_ClusterID(t *testing.T) {
+	h := NewHandler(false)
+	h.MetaClient.ClusterIDFn = func() uint64 { return 18446744073709551615 }
+
+	for _, method := range []string{"GET", "HEAD"} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, MustNewRequest(method, "/ping", nil))
+		if got, exp := w.Header().Get("X-Influxdb-Cluster-Id"), "18446744073709551615"; got != exp {
+			t.Fatalf("%s: unexpected cluster id: got %q, exp %q", method, got, exp)
+		}
+	}
+}
+
 // Ensure the handler returns the version correctly from the different endpoints.
 func TestHandler_Version(t *testing.T) {
 	h := NewHandler(false)
@@ -920,6 +934,7 @@ func NewHandler(requireAuthentication bool) *Handler {
 	}
 
 	h.MetaClient = &internal.MetaClientMock{}
+	h.MetaClient.ClusterIDFn = func() uint64 { return 0 }
 
 	h.Handler.MetaClient = h.MetaClient
 	h.Handler.QueryExecutor = query.NewExecutor()
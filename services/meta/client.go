@@ -42,6 +42,10 @@ var (
 
 	// ErrService is returned when the meta service returns an error.
 	ErrService = errors.New("meta service error")
+
+	// ErrReadOnly is returned when a read-only client is asked to change
+	// the meta data.
+	ErrReadOnly = errors.New("meta client is read-only")
 )
 
 // Client is used to execute commands on and read data from
@@ -54,12 +58,52 @@ type Client struct {
 	changed   chan struct{}
 	cacheData *Data
 
+	// Watches notified of changes to individual databases, by database name.
+	watches map[string]map[*Watch]struct{}
+
 	// Authentication cache.
 	authCache map[string]authUser
 
 	path string
 
 	retentionAutoCreate bool
+
+	// When set, all changes to the meta data are rejected.
+	readOnly bool
+
+	// Optional receiver of commit measurements.
+	metrics Metrics
+}
+
+// Metrics receives measurements of the changes committed by a Client so
+// that an embedding service can export them, for example through the
+// monitor service. Its methods are called with the client mutex held and
+// must not call back into the client.
+type Metrics interface {
+	// Commit is called after every attempt to persist a change. op is the
+	// name of the Client method making the change, d is the time taken to
+	// write meta.db, size is the number of bytes written and err is the
+	// error the commit failed with, if any.
+	Commit(op string, d time.Duration, size int, err error)
+}
+
+// A ClientOption is a functional option for changing the behavior of a Client.
+type ClientOption func(c *Client)
+
+// ReadOnly returns an option that makes the client serve reads from the
+// meta data loaded on Open and reject every change with ErrReadOnly.
+// A read-only client never writes to the meta directory.
+func ReadOnly() ClientOption {
+	return func(c *Client) {
+		c.readOnly = true
+	}
+}
+
+// WithMetrics returns an option that reports every commit to m.
+func WithMetrics(m Metrics) ClientOption {
+	return func(c *Client) {
+		c.metrics = m
+	}
 }
 
 type authUser struct {
@@ -69,19 +113,26 @@ type authUser struct {
 }
 
 // NewClient returns a new *Client.
-func NewClient(config *Config) *Client {
-	return &Client{
+func NewClient(config *Config, options ...ClientOption) *Client {
+	c := &Client{
 		cacheData: &Data{
 			ClusterID: uint64(rand.Int63()),
 			Index:     1,
 		},
 		closing:             make(chan struct{}),
 		changed:             make(chan struct{}),
+		watches:             make(map[string]map[*Watch]struct{}),
 		logger:              zap.NewNop(),
 		authCache:           make(map[string]authUser),
 		path:                config.Dir,
 		retentionAutoCreate: config.RetentionAutoCreate,
 	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
 }
 
 // Open a connection to a meta service cluster.
@@ -95,8 +146,8 @@ func (c *Client) Open() error {
 	}
 
 	// If this is a brand new instance, persist to disk immediatly.
-	if c.cacheData.Index == 1 {
-		if err := snapshot(c.path, c.cacheData); err != nil {
+	if c.cacheData.Index == 1 && !c.readOnly {
+		if _, err := snapshot(c.path, c.cacheData); err != nil {
 			return err
 		}
 	}
@@ -120,6 +171,12 @@ func (c *Client) Close() error {
 		close(c.closing)
 	}
 
+	for _, ws := range c.watches {
+		for w := range ws {
+			c.unwatch(w)
+		}
+	}
+
 	return nil
 }
 
@@ -192,7 +249,7 @@ func (c *Client) CreateDatabase(name string) (*DatabaseInfo, error) {
 
 	db := data.Database(name)
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("CreateDatabase", data); err != nil {
 		return nil, err
 	}
 
@@ -256,7 +313,7 @@ func (c *Client) CreateDatabaseWithRetentionPolicy(name string, spec *RetentionP
 	}
 
 	// Commit the changes.
-	if err := c.commit(data); err != nil {
+	if err := c.commit("CreateDatabaseWithRetentionPolicy", data); err != nil {
 		return nil, err
 	}
 
@@ -277,7 +334,7 @@ func (c *Client) DropDatabase(name string) error {
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("DropDatabase", data); err != nil {
 		return err
 	}
 
@@ -300,7 +357,7 @@ func (c *Client) CreateRetentionPolicy(database string, spec *RetentionPolicySpe
 		return nil, err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("CreateRetentionPolicy", data); err != nil {
 		return nil, err
 	}
 
@@ -331,7 +388,7 @@ func (c *Client) DropRetentionPolicy(database, name string) error {
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("DropRetentionPolicy", data); err != nil {
 		return err
 	}
 
@@ -349,7 +406,103 @@ func (c *Client) UpdateRetentionPolicy(database, name string, rpu *RetentionPoli
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("UpdateRetentionPolicy", data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DatabaseLabels returns a copy of the labels on a database.
+func (c *Client) DatabaseLabels(name string) (map[string]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	di := c.cacheData.Database(name)
+	if di == nil {
+		return nil, influxdb.ErrDatabaseNotFound(name)
+	}
+	return cloneLabels(di.Labels), nil
+}
+
+// SetDatabaseLabels replaces the labels on a database. A nil or empty map
+// removes all labels.
+func (c *Client) SetDatabaseLabels(name string, labels map[string]string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.SetDatabaseLabels(name, labels); err != nil {
+		return err
+	}
+
+	if err := c.commit("SetDatabaseLabels", data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RetentionPolicyLabels returns a copy of the labels on a retention policy.
+func (c *Client) RetentionPolicyLabels(database, name string) (map[string]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	rpi, err := c.cacheData.RetentionPolicy(database, name)
+	if err != nil {
+		return nil, err
+	} else if rpi == nil {
+		return nil, influxdb.ErrRetentionPolicyNotFound(name)
+	}
+	return cloneLabels(rpi.Labels), nil
+}
+
+// SetRetentionPolicyLabels replaces the labels on a retention policy. A nil
+// or empty map removes all labels.
+func (c *Client) SetRetentionPolicyLabels(database, name string, labels map[string]string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.SetRetentionPolicyLabels(database, name, labels); err != nil {
+		return err
+	}
+
+	if err := c.commit("SetRetentionPolicyLabels", data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DatabaseExpiry returns the time after which a database expires, or a zero
+// time if it never expires.
+func (c *Client) DatabaseExpiry(name string) (time.Time, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	di := c.cacheData.Database(name)
+	if di == nil {
+		return time.Time{}, influxdb.ErrDatabaseNotFound(name)
+	}
+	return di.ExpiresAt, nil
+}
+
+// SetDatabaseExpiry sets the time after which the retention service drops a
+// database. A zero time clears the expiry.
+func (c *Client) SetDatabaseExpiry(name string, t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.SetDatabaseExpiry(name, t); err != nil {
+		return err
+	}
+
+	if err := c.commit("SetDatabaseExpiry", data); err != nil {
 		return err
 	}
 
@@ -432,7 +585,7 @@ func (c *Client) CreateUser(name, password string, admin bool) (User, error) {
 
 	u := data.user(name)
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("CreateUser", data); err != nil {
 		return nil, err
 	}
 
@@ -458,7 +611,7 @@ func (c *Client) UpdateUser(name, password string) error {
 
 	delete(c.authCache, name)
 
-	return c.commit(data)
+	return c.commit("UpdateUser", data)
 }
 
 // DropUser removes the user with the given name.
@@ -472,7 +625,7 @@ func (c *Client) DropUser(name string) error {
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("DropUser", data); err != nil {
 		return err
 	}
 
@@ -490,7 +643,7 @@ func (c *Client) SetPrivilege(username, database string, p influxql.Privilege) e
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("SetPrivilege", data); err != nil {
 		return err
 	}
 
@@ -508,7 +661,7 @@ func (c *Client) SetAdminPrivilege(username string, admin bool) error {
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("SetAdminPrivilege", data); err != nil {
 		return err
 	}
 
@@ -665,7 +818,7 @@ func (c *Client) DropShard(id uint64) error {
 
 	data := c.cacheData.Clone()
 	data.DropShard(id)
-	return c.commit(data)
+	return c.commit("DropShard", data)
 }
 
 // TruncateShardGroups truncates any shard group that could contain timestamps beyond t.
@@ -675,7 +828,7 @@ func (c *Client) TruncateShardGroups(t time.Time) error {
 
 	data := c.cacheData.Clone()
 	data.TruncateShardGroups(t)
-	return c.commit(data)
+	return c.commit("TruncateShardGroups", data)
 }
 
 // PruneShardGroups remove deleted shard groups from the data store.
@@ -699,7 +852,7 @@ func (c *Client) PruneShardGroups() error {
 		}
 	}
 	if changed {
-		return c.commit(data)
+		return c.commit("PruneShardGroups", data)
 	}
 	return nil
 }
@@ -728,7 +881,7 @@ func (c *Client) CreateShardGroup(database, policy string, timestamp time.Time)
 		return nil, err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("CreateShardGroup", data); err != nil {
 		return nil, err
 	}
 
@@ -767,7 +920,7 @@ func (c *Client) DeleteShardGroup(database, policy string, id uint64) error {
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("DeleteShardGroup", data); err != nil {
 		return err
 	}
 
@@ -822,7 +975,7 @@ func (c *Client) PrecreateShardGroups(from, to time.Time) error {
 	}
 
 	if changed {
-		if err := c.commit(data); err != nil {
+		if err := c.commit("PrecreateShardGroups", data); err != nil {
 			return err
 		}
 	}
@@ -867,7 +1020,7 @@ func (c *Client) CreateContinuousQuery(database, name, query string) error {
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("CreateContinuousQuery", data); err != nil {
 		return err
 	}
 
@@ -885,7 +1038,7 @@ func (c *Client) DropContinuousQuery(database, name string) error {
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("DropContinuousQuery", data); err != nil {
 		return err
 	}
 
@@ -903,13 +1056,32 @@ func (c *Client) CreateSubscription(database, rp, name, mode string, destination
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("CreateSubscription", data); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// Subscriptions returns the subscriptions on the given database and retention policy.
+func (c *Client) Subscriptions(database, rp string) ([]SubscriptionInfo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	rpi, err := c.cacheData.RetentionPolicy(database, rp)
+	if err != nil {
+		return nil, err
+	} else if rpi == nil {
+		return nil, influxdb.ErrRetentionPolicyNotFound(rp)
+	}
+
+	subs := make([]SubscriptionInfo, len(rpi.Subscriptions))
+	for i := range rpi.Subscriptions {
+		subs[i] = rpi.Subscriptions[i].clone()
+	}
+	return subs, nil
+}
+
 // DropSubscription removes the named subscription from the given database and retention policy.
 func (c *Client) DropSubscription(database, rp, name string) error {
 	c.mu.Lock()
@@ -921,7 +1093,7 @@ func (c *Client) DropSubscription(database, rp, name string) error {
 		return err
 	}
 
-	if err := c.commit(data); err != nil {
+	if err := c.commit("DropSubscription", data); err != nil {
 		return err
 	}
 
@@ -931,6 +1103,11 @@ func (c *Client) DropSubscription(database, rp, name string) error {
 // SetData overwrites the underlying data in the meta store.
 func (c *Client) SetData(data *Data) error {
 	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.readOnly {
+		return ErrReadOnly
+	}
 
 	// reset the index so the commit will fire a change event
 	c.cacheData.Index = 0
@@ -939,13 +1116,7 @@ func (c *Client) SetData(data *Data) error {
 	d := data.Clone()
 	d.Index++
 
-	if err := c.commit(d); err != nil {
-		return err
-	}
-
-	c.mu.Unlock()
-
-	return nil
+	return c.commit("SetData", d)
 }
 
 // Data returns a clone of the underlying data in the meta store.
@@ -964,23 +1135,36 @@ func (c *Client) WaitForDataChanged() chan struct{} {
 	return c.changed
 }
 
-// commit writes data to the underlying store.
+// commit writes data to the underlying store. op names the operation
+// making the change and is reported to the client's Metrics.
 // This method assumes c's mutex is already locked.
-func (c *Client) commit(data *Data) error {
+func (c *Client) commit(op string, data *Data) error {
+	if c.readOnly {
+		return ErrReadOnly
+	}
+
 	data.Index++
 
 	// try to write to disk before updating in memory
-	if err := snapshot(c.path, data); err != nil {
+	start := time.Now()
+	n, err := snapshot(c.path, data)
+	if c.metrics != nil {
+		c.metrics.Commit(op, time.Since(start), n, err)
+	}
+	if err != nil {
 		return err
 	}
 
 	// update in memory
+	prev := c.cacheData
 	c.cacheData = data
 
 	// close channels to signal changes
 	close(c.changed)
 	c.changed = make(chan struct{})
 
+	c.notifyWatches(prev, data)
+
 	return nil
 }
 
@@ -998,38 +1182,39 @@ func (c *Client) WithLogger(log *zap.Logger) {
 	c.logger = log.With(zap.String("service", "metaclient"))
 }
 
-// snapshot saves the current meta data to disk.
-func snapshot(path string, data *Data) error {
+// snapshot saves the current meta data to disk and returns the number of
+// bytes written.
+func snapshot(path string, data *Data) (int, error) {
 	file := filepath.Join(path, metaFile)
 	tmpFile := file + "tmp"
 
 	f, err := os.Create(tmpFile)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer f.Close()
 
 	var d []byte
 	if b, err := data.MarshalBinary(); err != nil {
-		return err
+		return 0, err
 	} else {
 		d = b
 	}
 
 	if _, err := f.Write(d); err != nil {
-		return err
+		return 0, err
 	}
 
 	if err = f.Sync(); err != nil {
-		return err
+		return 0, err
 	}
 
 	//close file handle before renaming to support Windows
 	if err = f.Close(); err != nil {
-		return err
+		return 0, err
 	}
 
-	return renameFile(tmpFile, file)
+	return len(d), renameFile(tmpFile, file)
 }
 
 // Load loads the current meta data from disk.
@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"bytes"
 	"io/ioutil"
 	"os"
 	"path"
@@ -869,6 +870,44 @@ func TestMetaClient_Subscriptions_Create(t *testing.T) {
 	if err := c.CreateSubscription("db0", "autogen", "sub4", "ALL", []string{"https://example.com:9092"}); err != nil {
 		t.Fatal(err)
 	}
+
+	// Create an ANY subscription with several destinations.
+	if err := c.CreateSubscription("db0", "autogen", "sub5", "ANY", []string{"udp://example.com:9090", "udp://example.com:9091"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a subscription with an invalid mode.
+	err = c.CreateSubscription("db0", "autogen", "sub6", "SOME", []string{"udp://example.com:9090"})
+	if got, exp := err, meta.ErrInvalidSubscriptionMode("SOME"); got == nil || got.Error() != exp.Error() {
+		t.Fatalf("got: %v, exp: %s", got, exp)
+	}
+
+	// Create a subscription without destinations.
+	err = c.CreateSubscription("db0", "autogen", "sub6", "ALL", nil)
+	if got, exp := err, meta.ErrSubscriptionDestinationsRequired; got != exp {
+		t.Fatalf("got: %v, exp: %s", got, exp)
+	}
+
+	// List the subscriptions.
+	subs, err := c.Subscriptions("db0", "autogen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range subs {
+		names = append(names, s.Name)
+	}
+	if exp := []string{"sub0", "sub1", "sub3", "sub4", "sub5"}; !reflect.DeepEqual(names, exp) {
+		t.Fatalf("unexpected subscriptions: got %v, exp %v", names, exp)
+	} else if got := subs[4]; got.Mode != "ANY" || len(got.Destinations) != 2 {
+		t.Fatalf("unexpected subscription: %+v", got)
+	}
+
+	// Listing subscriptions on an unknown retention policy returns an error.
+	_, err = c.Subscriptions("db0", "foo_policy")
+	if got, exp := err, influxdb.ErrRetentionPolicyNotFound("foo_policy"); got == nil || got.Error() != exp.Error() {
+		t.Fatalf("got: %v, exp: %s", got, exp)
+	}
 }
 
 func TestMetaClient_Subscriptions_Drop(t *testing.T) {
@@ -916,6 +955,216 @@ func TestMetaClient_Subscriptions_Drop(t *testing.T) {
 	}
 }
 
+func TestMetaClient_WatchDatabase(t *testing.T) {
+	t.Parallel()
+
+	d, c := newClient()
+	defer os.RemoveAll(d)
+	defer c.Close()
+
+	w := c.WatchDatabase("db0")
+	defer w.Close()
+
+	// Changes to other databases are not delivered.
+	if _, err := c.CreateDatabase("db1"); err != nil {
+		t.Fatal(err)
+	}
+	expectNoEvent(t, w)
+
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+	ev := expectEvent(t, w, meta.DatabaseCreated)
+	if ev.Database != "db0" {
+		t.Fatalf("unexpected database: %s", ev.Database)
+	} else if ev.Info == nil || ev.Info.Name != "db0" {
+		t.Fatalf("unexpected database info: %+v", ev.Info)
+	} else if got, exp := ev.Index, c.Data().Index; got != exp {
+		t.Fatalf("unexpected index: got %d, exp %d", got, exp)
+	} else if ev.RetentionPolicies != nil {
+		t.Fatalf("unexpected retention policies: %v", ev.RetentionPolicies)
+	}
+
+	// Subscription and continuous query changes are delivered.
+	if err := c.CreateSubscription("db0", "autogen", "sub0", "ALL", []string{"udp://example.com:9090"}); err != nil {
+		t.Fatal(err)
+	}
+	ev = expectEvent(t, w, meta.DatabaseUpdated)
+	if subs := ev.Info.RetentionPolicy("autogen").Subscriptions; len(subs) != 1 {
+		t.Fatalf("unexpected subscriptions: %+v", subs)
+	}
+
+	// The event info is not modified by later changes.
+	if err := c.DropSubscription("db0", "autogen", "sub0"); err != nil {
+		t.Fatal(err)
+	}
+	if subs := ev.Info.RetentionPolicy("autogen").Subscriptions; len(subs) != 1 || subs[0].Name != "sub0" {
+		t.Fatalf("event info modified: %+v", subs)
+	}
+	expectEvent(t, w, meta.DatabaseUpdated)
+
+	if err := c.CreateContinuousQuery("db0", "cq0", `SELECT count(value) INTO foo_count FROM foo GROUP BY time(10m)`); err != nil {
+		t.Fatal(err)
+	}
+	expectEvent(t, w, meta.DatabaseUpdated)
+
+	// Unreceived events are coalesced into the latest one.
+	if _, err := c.CreateShardGroup("db0", "autogen", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DropDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+	ev = expectEvent(t, w, meta.DatabaseDropped)
+	if ev.Info != nil {
+		t.Fatalf("expected nil info for dropped database, got %+v", ev.Info)
+	}
+	expectNoEvent(t, w)
+
+	// Closing the watch closes the channel.
+	w.Close()
+	if _, ok := <-w.C; ok {
+		t.Fatal("expected closed channel")
+	}
+}
+
+func TestMetaClient_WatchRetentionPolicies(t *testing.T) {
+	t.Parallel()
+
+	d, c := newClient()
+	defer os.RemoveAll(d)
+	defer c.Close()
+
+	w := c.WatchRetentionPolicies("db0")
+	defer w.Close()
+
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+	ev := expectEvent(t, w, meta.DatabaseCreated)
+	if exp := []string{"autogen"}; !reflect.DeepEqual(ev.RetentionPolicies, exp) {
+		t.Fatalf("unexpected retention policies: got %v, exp %v", ev.RetentionPolicies, exp)
+	}
+
+	// Shard group, subscription and continuous query changes are not delivered.
+	if _, err := c.CreateShardGroup("db0", "autogen", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateSubscription("db0", "autogen", "sub0", "ALL", []string{"udp://example.com:9090"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateContinuousQuery("db0", "cq0", `SELECT count(value) INTO foo_count FROM foo GROUP BY time(10m)`); err != nil {
+		t.Fatal(err)
+	}
+	expectNoEvent(t, w)
+
+	// Creating a new default policy reports it and the previous default.
+	duration := 2 * time.Hour
+	if _, err := c.CreateRetentionPolicy("db0", &meta.RetentionPolicySpec{
+		Name:     "rp0",
+		Duration: &duration,
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+	ev = expectEvent(t, w, meta.DatabaseUpdated)
+	if exp := []string{"autogen", "rp0"}; !reflect.DeepEqual(ev.RetentionPolicies, exp) {
+		t.Fatalf("unexpected retention policies: got %v, exp %v", ev.RetentionPolicies, exp)
+	} else if ev.Info == nil || ev.Info.DefaultRetentionPolicy != "rp0" {
+		t.Fatalf("unexpected database info: %+v", ev.Info)
+	} else if got, exp := ev.Index, c.Data().Index; got != exp {
+		t.Fatalf("unexpected index: got %d, exp %d", got, exp)
+	}
+
+	// Updating a policy reports only that policy.
+	var rpu meta.RetentionPolicyUpdate
+	rpu.SetDuration(3 * time.Hour)
+	if err := c.UpdateRetentionPolicy("db0", "rp0", &rpu, false); err != nil {
+		t.Fatal(err)
+	}
+	ev = expectEvent(t, w, meta.DatabaseUpdated)
+	if exp := []string{"rp0"}; !reflect.DeepEqual(ev.RetentionPolicies, exp) {
+		t.Fatalf("unexpected retention policies: got %v, exp %v", ev.RetentionPolicies, exp)
+	}
+
+	// Dropping a policy reports it.
+	if err := c.DropRetentionPolicy("db0", "autogen"); err != nil {
+		t.Fatal(err)
+	}
+	ev = expectEvent(t, w, meta.DatabaseUpdated)
+	if exp := []string{"autogen"}; !reflect.DeepEqual(ev.RetentionPolicies, exp) {
+		t.Fatalf("unexpected retention policies: got %v, exp %v", ev.RetentionPolicies, exp)
+	}
+
+	// Dropping the database reports its remaining policies.
+	if err := c.DropDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+	ev = expectEvent(t, w, meta.DatabaseDropped)
+	if exp := []string{"rp0"}; !reflect.DeepEqual(ev.RetentionPolicies, exp) {
+		t.Fatalf("unexpected retention policies: got %v, exp %v", ev.RetentionPolicies, exp)
+	}
+}
+
+func TestMetaClient_WatchRetentionPolicies_NoAutoCreate(t *testing.T) {
+	t.Parallel()
+
+	cfg := newConfig()
+	defer os.RemoveAll(cfg.Dir)
+	cfg.RetentionAutoCreate = false
+
+	c := meta.NewClient(cfg)
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	w := c.WatchRetentionPolicies("db0")
+	defer w.Close()
+
+	// The database is created without policies; the watch still sees it created.
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+	ev := expectEvent(t, w, meta.DatabaseCreated)
+	if len(ev.RetentionPolicies) != 0 {
+		t.Fatalf("unexpected retention policies: %v", ev.RetentionPolicies)
+	} else if ev.Info == nil || len(ev.Info.RetentionPolicies) != 0 {
+		t.Fatalf("unexpected info: %+v", ev.Info)
+	}
+
+	// The first policy is an update to the existing database.
+	if _, err := c.CreateRetentionPolicy("db0", &meta.RetentionPolicySpec{Name: "rp0"}, true); err != nil {
+		t.Fatal(err)
+	}
+	ev = expectEvent(t, w, meta.DatabaseUpdated)
+	if exp := []string{"rp0"}; !reflect.DeepEqual(ev.RetentionPolicies, exp) {
+		t.Fatalf("unexpected retention policies: got %v, exp %v", ev.RetentionPolicies, exp)
+	}
+}
+
+func TestMetaClient_Watch_Close(t *testing.T) {
+	t.Parallel()
+
+	d, c := newClient()
+	defer os.RemoveAll(d)
+
+	// Closing the client closes existing watches.
+	w := c.WatchDatabase("db0")
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-w.C; ok {
+		t.Fatal("expected closed channel")
+	}
+
+	// Watches created after the client is closed are already closed.
+	w = c.WatchRetentionPolicies("db0")
+	if _, ok := <-w.C; ok {
+		t.Fatal("expected closed channel")
+	}
+	w.Close()
+}
+
 func TestMetaClient_Shards(t *testing.T) {
 	t.Parallel()
 
@@ -1134,6 +1383,424 @@ func TestMetaClient_PersistClusterIDAfterRestart(t *testing.T) {
 	}
 }
 
+func TestMetaClient_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	cfg := newConfig()
+	defer os.RemoveAll(cfg.Dir)
+
+	// A read-only client does not create meta data on disk.
+	c := meta.NewClient(cfg, meta.ReadOnly())
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+	if _, err := os.Stat(path.Join(cfg.Dir, "meta.db")); !os.IsNotExist(err) {
+		t.Fatalf("expected no meta.db, got: %v", err)
+	}
+
+	c = meta.NewClient(cfg)
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+
+	c = meta.NewClient(cfg, meta.ReadOnly())
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// Reads are served from the existing meta data.
+	if db := c.Database("db0"); db == nil {
+		t.Fatal("database not found")
+	}
+	index := c.Data().Index
+
+	// Changes are rejected and leave the meta data untouched.
+	if _, err := c.CreateDatabase("db1"); err != meta.ErrReadOnly {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.DropDatabase("db0"); err != meta.ErrReadOnly {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.CreateUser("admin", "pass", true); err != meta.ErrReadOnly {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.CreateShardGroup("db0", "autogen", time.Now()); err != meta.ErrReadOnly {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data := c.Data()
+	if err := c.SetData(&data); err != meta.ErrReadOnly {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if c.Database("db1") != nil {
+		t.Fatal("unexpected database db1")
+	} else if c.Database("db0") == nil {
+		t.Fatal("database db0 dropped")
+	} else if got := c.Data().Index; got != index {
+		t.Fatalf("unexpected index: got %d, exp %d", got, index)
+	}
+
+	// Creating an existing database is not a change.
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMetaClient_Labels(t *testing.T) {
+	t.Parallel()
+
+	cfg := newConfig()
+	defer os.RemoveAll(cfg.Dir)
+
+	c := meta.NewClient(cfg)
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+
+	dbWatch := c.WatchDatabase("db0")
+	rpWatch := c.WatchRetentionPolicies("db0")
+
+	labels := map[string]string{"owner": "alice", "team": "ops"}
+	if err := c.SetDatabaseLabels("db0", labels); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetRetentionPolicyLabels("db0", "autogen", map[string]string{"purpose": "raw"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Label changes update the database but not its retention policy definitions.
+	expectEvent(t, dbWatch, meta.DatabaseUpdated)
+	expectNoEvent(t, rpWatch)
+
+	// The client keeps its own copy of the labels.
+	labels["owner"] = "bob"
+	if got, err := c.DatabaseLabels("db0"); err != nil {
+		t.Fatal(err)
+	} else if exp := map[string]string{"owner": "alice", "team": "ops"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected database labels: got %v, exp %v", got, exp)
+	}
+
+	// Labels are persisted.
+	dbWatch.Close()
+	rpWatch.Close()
+	c.Close()
+	c = meta.NewClient(cfg)
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if exp := map[string]string{"owner": "alice", "team": "ops"}; !reflect.DeepEqual(c.Database("db0").Labels, exp) {
+		t.Fatalf("unexpected database labels: got %v, exp %v", c.Database("db0").Labels, exp)
+	}
+	if got, err := c.RetentionPolicyLabels("db0", "autogen"); err != nil {
+		t.Fatal(err)
+	} else if exp := map[string]string{"purpose": "raw"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected retention policy labels: got %v, exp %v", got, exp)
+	}
+
+	// Setting no labels removes them.
+	if err := c.SetDatabaseLabels("db0", nil); err != nil {
+		t.Fatal(err)
+	} else if got, _ := c.DatabaseLabels("db0"); len(got) != 0 {
+		t.Fatalf("unexpected database labels: %v", got)
+	}
+
+	if err := c.SetDatabaseLabels("db1", labels); err == nil || err.Error() != influxdb.ErrDatabaseNotFound("db1").Error() {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.SetRetentionPolicyLabels("db0", "rp1", labels); err == nil || err.Error() != influxdb.ErrRetentionPolicyNotFound("rp1").Error() {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.SetDatabaseLabels("db0", map[string]string{"": "x"}); err != meta.ErrLabelKeyRequired {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.DatabaseLabels("db1"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestMetaClient_DatabaseExpiry(t *testing.T) {
+	t.Parallel()
+
+	cfg := newConfig()
+	defer os.RemoveAll(cfg.Dir)
+
+	c := meta.NewClient(cfg)
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+
+	// New databases never expire.
+	if got, err := c.DatabaseExpiry("db0"); err != nil {
+		t.Fatal(err)
+	} else if !got.IsZero() {
+		t.Fatalf("unexpected expiry: %v", got)
+	}
+
+	dbWatch := c.WatchDatabase("db0")
+
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 6, time.UTC)
+	if err := c.SetDatabaseExpiry("db0", expiry); err != nil {
+		t.Fatal(err)
+	}
+	expectEvent(t, dbWatch, meta.DatabaseUpdated)
+
+	// The expiry is persisted.
+	dbWatch.Close()
+	c.Close()
+	c = meta.NewClient(cfg)
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if got, err := c.DatabaseExpiry("db0"); err != nil {
+		t.Fatal(err)
+	} else if !got.Equal(expiry) {
+		t.Fatalf("unexpected expiry: got %v, exp %v", got, expiry)
+	}
+
+	// A zero time clears the expiry.
+	if err := c.SetDatabaseExpiry("db0", time.Time{}); err != nil {
+		t.Fatal(err)
+	} else if got, _ := c.DatabaseExpiry("db0"); !got.IsZero() {
+		t.Fatalf("unexpected expiry: %v", got)
+	}
+
+	if err := c.SetDatabaseExpiry("db1", expiry); err == nil || err.Error() != influxdb.ErrDatabaseNotFound("db1").Error() {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.DatabaseExpiry("db1"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestMetaClient_ExportImportMeta(t *testing.T) {
+	t.Parallel()
+
+	d, src := newClient()
+	defer os.RemoveAll(d)
+	defer src.Close()
+
+	duration, replicaN := 2*time.Hour, 1
+	spec := meta.RetentionPolicySpec{Name: "rp0", Duration: &duration, ReplicaN: &replicaN, ShardGroupDuration: time.Hour}
+	if _, err := src.CreateDatabaseWithRetentionPolicy("db0", &spec); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.CreateRetentionPolicy("db0", &meta.RetentionPolicySpec{Name: "rp1"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.CreateShardGroup("db0", "rp0", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.CreateContinuousQuery("db0", "cq0", `SELECT count(value) INTO foo_count FROM foo GROUP BY time(10m)`); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.CreateSubscription("db0", "rp0", "sub0", "ANY", []string{"udp://example.com:9090"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.CreateUser("admin", "secret", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.CreateUser("reader", "password", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.SetPrivilege("reader", "db0", influxql.ReadPrivilege); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.SetDatabaseLabels("db0", map[string]string{"owner": "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.SetRetentionPolicyLabels("db0", "rp0", map[string]string{"purpose": "raw"}); err != nil {
+		t.Fatal(err)
+	}
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := src.SetDatabaseExpiry("db0", expiry); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := src.ExportMeta(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); !strings.Contains(s, `"version": 1`) {
+		t.Fatalf("missing version in export: %s", s)
+	} else if strings.Contains(s, "shard_groups") || strings.Contains(s, "cluster") {
+		t.Fatalf("unexpected shard data in export: %s", s)
+	}
+	export := buf.String()
+
+	d2, dst := newClient()
+	defer os.RemoveAll(d2)
+	defer dst.Close()
+
+	if err := dst.ImportMeta(strings.NewReader(export)); err != nil {
+		t.Fatal(err)
+	}
+
+	db := dst.Database("db0")
+	if db == nil {
+		t.Fatal("database not imported")
+	} else if db.DefaultRetentionPolicy != "rp0" {
+		t.Fatalf("unexpected default retention policy: %s", db.DefaultRetentionPolicy)
+	} else if len(db.RetentionPolicies) != 2 {
+		t.Fatalf("unexpected retention policies: %v", db.RetentionPolicies)
+	}
+
+	rp := db.RetentionPolicy("rp0")
+	if rp.Duration != 2*time.Hour || rp.ShardGroupDuration != time.Hour || rp.ReplicaN != 1 {
+		t.Fatalf("unexpected retention policy: %+v", rp)
+	} else if len(rp.ShardGroups) != 0 {
+		t.Fatalf("unexpected shard groups: %v", rp.ShardGroups)
+	} else if exp := []meta.SubscriptionInfo{{Name: "sub0", Mode: "ANY", Destinations: []string{"udp://example.com:9090"}}}; !reflect.DeepEqual(rp.Subscriptions, exp) {
+		t.Fatalf("unexpected subscriptions: %v", rp.Subscriptions)
+	}
+	if exp := map[string]string{"owner": "alice"}; !reflect.DeepEqual(db.Labels, exp) {
+		t.Fatalf("unexpected database labels: %v", db.Labels)
+	} else if exp := map[string]string{"purpose": "raw"}; !reflect.DeepEqual(rp.Labels, exp) {
+		t.Fatalf("unexpected retention policy labels: %v", rp.Labels)
+	} else if !db.ExpiresAt.Equal(expiry) {
+		t.Fatalf("unexpected database expiry: %v", db.ExpiresAt)
+	}
+	if rp := db.RetentionPolicy("rp1"); rp == nil || rp.Duration != 0 {
+		t.Fatalf("unexpected retention policy: %+v", rp)
+	}
+	if exp := []meta.ContinuousQueryInfo{{Name: "cq0", Query: `SELECT count(value) INTO foo_count FROM foo GROUP BY time(10m)`}}; !reflect.DeepEqual(db.ContinuousQueries, exp) {
+		t.Fatalf("unexpected continuous queries: %v", db.ContinuousQueries)
+	}
+
+	// Users keep their passwords and privileges.
+	if u, err := dst.Authenticate("admin", "secret"); err != nil {
+		t.Fatal(err)
+	} else if !u.(*meta.UserInfo).Admin {
+		t.Fatal("expected admin user")
+	}
+	if _, err := dst.Authenticate("reader", "password"); err != nil {
+		t.Fatal(err)
+	}
+	if p, err := dst.UserPrivilege("reader", "db0"); err != nil {
+		t.Fatal(err)
+	} else if *p != influxql.ReadPrivilege {
+		t.Fatalf("unexpected privilege: %s", p)
+	}
+
+	// Importing over existing meta data fails without changing anything,
+	// and the error names the entry that failed.
+	index := dst.Data().Index
+	for _, tt := range []struct {
+		doc string
+		err string
+	}{
+		{
+			doc: export,
+			err: `import database "db0": ` + meta.ErrDatabaseExists.Error(),
+		},
+		{
+			doc: `{"version": 1, "users": [{"name": "reader", "hash": "x"}]}`,
+			err: `import user "reader": ` + meta.ErrUserExists.Error(),
+		},
+		{
+			doc: `{"version": 1, "databases": [{"name": "db1", "retention_policies": [{"name": "rp0", "duration": "0s", "shard_group_duration": "0s", "replica_n": 1, "subscriptions": [{"name": "sub0", "mode": "SOME", "destinations": ["udp://h:1"]}]}]}]}`,
+			err: `import database "db1": retention policy "rp0": subscription "sub0": ` + meta.ErrInvalidSubscriptionMode("SOME").Error(),
+		},
+		{
+			doc: `{"version": 1, "databases": [{"name": "db1", "retention_policies": [{"name": "rp0", "duration": "forever"}]}]}`,
+			err: `import database "db1": retention policy "rp0": time: invalid duration`,
+		},
+	} {
+		if err := dst.ImportMeta(strings.NewReader(tt.doc)); err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+			t.Fatalf("unexpected error: got %v, exp %s", err, tt.err)
+		} else if got := dst.Data().Index; got != index {
+			t.Fatalf("unexpected index: got %d, exp %d", got, index)
+		} else if dst.Database("db1") != nil {
+			t.Fatal("unexpected database db1")
+		}
+	}
+
+	if err := dst.ImportMeta(strings.NewReader(`{"version": 2}`)); err == nil || err.Error() != "unsupported meta export version: 2" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMetaClient_Metrics(t *testing.T) {
+	t.Parallel()
+
+	cfg := newConfig()
+	defer os.RemoveAll(cfg.Dir)
+
+	var m commitMetrics
+	c := meta.NewClient(cfg, meta.WithMetrics(&m))
+	if err := c.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// Opening a new store is not a commit.
+	if len(m.ops) != 0 {
+		t.Fatalf("unexpected commits: %v", m.ops)
+	}
+
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DropDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Creating an existing user is rejected before anything is committed.
+	if _, err := c.CreateUser("admin", "pass", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateUser("admin", "other", true); err != meta.ErrUserExists {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exp := []string{"CreateDatabase", "DropDatabase", "CreateUser"}; !reflect.DeepEqual(m.ops, exp) {
+		t.Fatalf("unexpected ops: got %v, exp %v", m.ops, exp)
+	}
+	for i, err := range m.errs {
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", m.ops[i], err)
+		}
+	}
+
+	// The reported size is the size of meta.db.
+	fi, err := os.Stat(path.Join(cfg.Dir, "meta.db"))
+	if err != nil {
+		t.Fatal(err)
+	} else if got := m.sizes[len(m.sizes)-1]; int64(got) != fi.Size() {
+		t.Fatalf("unexpected size: got %d, exp %d", got, fi.Size())
+	}
+}
+
+// commitMetrics records the commits reported by a client.
+type commitMetrics struct {
+	ops   []string
+	sizes []int
+	errs  []error
+}
+
+func (m *commitMetrics) Commit(op string, d time.Duration, size int, err error) {
+	m.ops = append(m.ops, op)
+	m.sizes = append(m.sizes, size)
+	m.errs = append(m.errs, err)
+}
+
 func newClient() (string, *meta.Client) {
 	cfg := newConfig()
 	c := meta.NewClient(cfg)
@@ -1164,6 +1831,31 @@ func testTempDir(skip int) string {
 	return dir
 }
 
+// expectEvent returns the pending event on w and fails unless it has type typ.
+func expectEvent(t *testing.T, w *meta.Watch, typ meta.DatabaseEventType) meta.DatabaseEvent {
+	t.Helper()
+	select {
+	case ev := <-w.C:
+		if ev.Type != typ {
+			t.Fatalf("unexpected event type: got %s, exp %s", ev.Type, typ)
+		}
+		return ev
+	default:
+		t.Fatalf("expected %s event", typ)
+	}
+	return meta.DatabaseEvent{}
+}
+
+// expectNoEvent fails if an event is pending on w.
+func expectNoEvent(t *testing.T, w *meta.Watch) {
+	t.Helper()
+	select {
+	case ev := <-w.C:
+		t.Fatalf("unexpected event: %+v", ev)
+	default:
+	}
+}
+
 func isAdmin(u meta.User) bool {
 	ui := u.(*meta.UserInfo)
 	return ui.Admin
@@ -263,6 +263,51 @@ func (data *Data) UpdateRetentionPolicy(database, name string, rpu *RetentionPol
 	return nil
 }
 
+// SetDatabaseLabels replaces the labels on a database.
+func (data *Data) SetDatabaseLabels(name string, labels map[string]string) error {
+	if err := validateLabels(labels); err != nil {
+		return err
+	}
+
+	di := data.Database(name)
+	if di == nil {
+		return influxdb.ErrDatabaseNotFound(name)
+	}
+	di.Labels = cloneLabels(labels)
+	return nil
+}
+
+// SetRetentionPolicyLabels replaces the labels on a retention policy.
+func (data *Data) SetRetentionPolicyLabels(database, name string, labels map[string]string) error {
+	if err := validateLabels(labels); err != nil {
+		return err
+	}
+
+	rpi, err := data.RetentionPolicy(database, name)
+	if err != nil {
+		return err
+	} else if rpi == nil {
+		return influxdb.ErrRetentionPolicyNotFound(name)
+	}
+	rpi.Labels = cloneLabels(labels)
+	return nil
+}
+
+// SetDatabaseExpiry sets the time after which a database expires. A zero
+// time clears the expiry.
+func (data *Data) SetDatabaseExpiry(name string, t time.Time) error {
+	di := data.Database(name)
+	if di == nil {
+		return influxdb.ErrDatabaseNotFound(name)
+	}
+	if t.IsZero() {
+		di.ExpiresAt = time.Time{}
+	} else {
+		di.ExpiresAt = t.UTC()
+	}
+	return nil
+}
+
 // DropShard removes a shard by ID.
 //
 // DropShard won't return an error if the shard can't be found, which
@@ -474,6 +519,12 @@ func validateURL(input string) error {
 
 // CreateSubscription adds a named subscription to a database and retention policy.
 func (data *Data) CreateSubscription(database, rp, name, mode string, destinations []string) error {
+	if mode != "ANY" && mode != "ALL" {
+		return ErrInvalidSubscriptionMode(mode)
+	} else if len(destinations) == 0 {
+		return ErrSubscriptionDestinationsRequired
+	}
+
 	for _, d := range destinations {
 		if err := validateURL(d); err != nil {
 			return err
@@ -910,6 +961,13 @@ type DatabaseInfo struct {
 	DefaultRetentionPolicy string
 	RetentionPolicies      []RetentionPolicyInfo
 	ContinuousQueries      []ContinuousQueryInfo
+
+	// Labels are key/value annotations set by operators, such as an owner.
+	Labels map[string]string
+
+	// ExpiresAt is the time after which the retention service drops the
+	// database. A zero time means the database never expires.
+	ExpiresAt time.Time
 }
 
 // RetentionPolicy returns a retention policy by name.
@@ -973,6 +1031,8 @@ func (di DatabaseInfo) clone() DatabaseInfo {
 		}
 	}
 
+	other.Labels = cloneLabels(di.Labels)
+
 	return other
 }
 
@@ -991,6 +1051,12 @@ func (di DatabaseInfo) marshal() *internal.DatabaseInfo {
 	for i := range di.ContinuousQueries {
 		pb.ContinuousQueries[i] = di.ContinuousQueries[i].marshal()
 	}
+
+	pb.Labels = marshalLabels(di.Labels)
+
+	if !di.ExpiresAt.IsZero() {
+		pb.ExpiresAt = proto.Int64(MarshalTime(di.ExpiresAt))
+	}
 	return pb
 }
 
@@ -1012,6 +1078,9 @@ func (di *DatabaseInfo) unmarshal(pb *internal.DatabaseInfo) {
 			di.ContinuousQueries[i].unmarshal(x)
 		}
 	}
+
+	di.Labels = unmarshalLabels(pb.GetLabels())
+	di.ExpiresAt = UnmarshalTime(pb.GetExpiresAt())
 }
 
 // RetentionPolicySpec represents the specification for a new retention policy.
@@ -1106,6 +1175,9 @@ type RetentionPolicyInfo struct {
 	ShardGroupDuration time.Duration
 	ShardGroups        []ShardGroupInfo
 	Subscriptions      []SubscriptionInfo
+
+	// Labels are key/value annotations set by operators, such as an owner.
+	Labels map[string]string
 }
 
 // NewRetentionPolicyInfo returns a new instance of RetentionPolicyInfo
@@ -1202,6 +1274,8 @@ func (rpi *RetentionPolicyInfo) marshal() *internal.RetentionPolicyInfo {
 		pb.Subscriptions[i] = sub.marshal()
 	}
 
+	pb.Labels = marshalLabels(rpi.Labels)
+
 	return pb
 }
 
@@ -1224,6 +1298,8 @@ func (rpi *RetentionPolicyInfo) unmarshal(pb *internal.RetentionPolicyInfo) {
 			rpi.Subscriptions[i].unmarshal(x)
 		}
 	}
+
+	rpi.Labels = unmarshalLabels(pb.GetLabels())
 }
 
 // clone returns a deep copy of rpi.
@@ -1237,6 +1313,15 @@ func (rpi RetentionPolicyInfo) clone() RetentionPolicyInfo {
 		}
 	}
 
+	if rpi.Subscriptions != nil {
+		other.Subscriptions = make([]SubscriptionInfo, len(rpi.Subscriptions))
+		for i := range rpi.Subscriptions {
+			other.Subscriptions[i] = rpi.Subscriptions[i].clone()
+		}
+	}
+
+	other.Labels = cloneLabels(rpi.Labels)
+
 	return other
 }
 
@@ -1255,6 +1340,62 @@ func (rpi *RetentionPolicyInfo) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+// validateLabels returns an error if any label has an empty key.
+func validateLabels(labels map[string]string) error {
+	for k := range labels {
+		if k == "" {
+			return ErrLabelKeyRequired
+		}
+	}
+	return nil
+}
+
+// cloneLabels returns a copy of labels, or nil if there are none.
+func cloneLabels(labels map[string]string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+	other := make(map[string]string, len(labels))
+	for k, v := range labels {
+		other[k] = v
+	}
+	return other
+}
+
+// marshalLabels serializes labels to a protobuf representation, sorted by key.
+func marshalLabels(labels map[string]string) []*internal.Label {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pb := make([]*internal.Label, len(keys))
+	for i, k := range keys {
+		pb[i] = &internal.Label{
+			Key:   proto.String(k),
+			Value: proto.String(labels[k]),
+		}
+	}
+	return pb
+}
+
+// unmarshalLabels deserializes labels from a protobuf representation.
+func unmarshalLabels(pb []*internal.Label) map[string]string {
+	if len(pb) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(pb))
+	for _, l := range pb {
+		labels[l.GetKey()] = l.GetValue()
+	}
+	return labels
+}
+
 // shardGroupDuration returns the default duration for a shard group based on a policy duration.
 func shardGroupDuration(d time.Duration) time.Duration {
 	if d >= 180*24*time.Hour || d == 0 { // 6 months or 0
@@ -1489,6 +1630,18 @@ type SubscriptionInfo struct {
 	Destinations []string
 }
 
+// clone returns a deep copy of si.
+func (si SubscriptionInfo) clone() SubscriptionInfo {
+	other := si
+
+	if si.Destinations != nil {
+		other.Destinations = make([]string, len(si.Destinations))
+		copy(other.Destinations, si.Destinations)
+	}
+
+	return other
+}
+
 // marshal serializes to a protobuf representation.
 func (si SubscriptionInfo) marshal() *internal.SubscriptionInfo {
 	pb := &internal.SubscriptionInfo{
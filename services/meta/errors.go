@@ -87,12 +87,20 @@ var (
 	ErrContinuousQueryNotFound = errors.New("continuous query not found")
 )
 
+var (
+	// ErrLabelKeyRequired is returned when setting a label with an empty key.
+	ErrLabelKeyRequired = errors.New("label key required")
+)
+
 var (
 	// ErrSubscriptionExists is returned when creating an already existing subscription.
 	ErrSubscriptionExists = errors.New("subscription already exists")
 
 	// ErrSubscriptionNotFound is returned when removing a subscription that doesn't exist.
 	ErrSubscriptionNotFound = errors.New("subscription not found")
+
+	// ErrSubscriptionDestinationsRequired is returned when creating a subscription without destinations.
+	ErrSubscriptionDestinationsRequired = errors.New("subscription destinations required")
 )
 
 // ErrInvalidSubscriptionURL is returned when the subscription's destination URL is invalid.
@@ -100,6 +108,11 @@ func ErrInvalidSubscriptionURL(url string) error {
 	return fmt.Errorf("invalid subscription URL: %s", url)
 }
 
+// ErrInvalidSubscriptionMode is returned when the subscription's mode is not ANY or ALL.
+func ErrInvalidSubscriptionMode(mode string) error {
+	return fmt.Errorf("invalid subscription mode: %s", mode)
+}
+
 var (
 	// ErrUserExists is returned when creating an already existing user.
 	ErrUserExists = errors.New("user already exists")
@@ -0,0 +1,250 @@
+package meta
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/influxdata/influxql"
+)
+
+// ExportVersion is the version of the document written by ExportMeta.
+const ExportVersion = 1
+
+// exportDocument is the portable JSON form of the meta data. Shards, shard
+// groups, nodes and the cluster ID are deliberately left out so the document
+// can be imported into a different cluster.
+type exportDocument struct {
+	Version   int              `json:"version"`
+	Databases []exportDatabase `json:"databases"`
+	Users     []exportUser     `json:"users"`
+}
+
+type exportDatabase struct {
+	Name                   string                  `json:"name"`
+	DefaultRetentionPolicy string                  `json:"default_retention_policy,omitempty"`
+	RetentionPolicies      []exportRetentionPolicy `json:"retention_policies"`
+	ContinuousQueries      []exportContinuousQuery `json:"continuous_queries"`
+	Labels                 map[string]string       `json:"labels,omitempty"`
+	ExpiresAt              *time.Time              `json:"expires_at,omitempty"`
+}
+
+// exportRetentionPolicy stores durations in time.Duration string form,
+// with "0s" meaning an infinite duration.
+type exportRetentionPolicy struct {
+	Name               string               `json:"name"`
+	Duration           string               `json:"duration"`
+	ShardGroupDuration string               `json:"shard_group_duration"`
+	ReplicaN           int                  `json:"replica_n"`
+	Subscriptions      []exportSubscription `json:"subscriptions"`
+	Labels             map[string]string    `json:"labels,omitempty"`
+}
+
+type exportContinuousQuery struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+}
+
+type exportSubscription struct {
+	Name         string   `json:"name"`
+	Mode         string   `json:"mode"`
+	Destinations []string `json:"destinations"`
+}
+
+// exportUser includes the bcrypt hash of the password so users can log in
+// with the same credentials after an import.
+type exportUser struct {
+	Name       string            `json:"name"`
+	Hash       string            `json:"hash"`
+	Admin      bool              `json:"admin"`
+	Privileges map[string]string `json:"privileges,omitempty"`
+}
+
+// ExportMeta writes the databases, retention policies, continuous queries,
+// subscriptions, labels, database expiry times and users to w as a versioned JSON document.
+func (c *Client) ExportMeta(w io.Writer) error {
+	c.mu.RLock()
+	doc := newExportDocument(c.cacheData)
+	c.mu.RUnlock()
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// ImportMeta reads a document written by ExportMeta from r and creates
+// everything it describes. The import is applied as a single change and
+// fails without modifying the meta data if any database or user in the
+// document already exists.
+func (c *Client) ImportMeta(r io.Reader) error {
+	var doc exportDocument
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return err
+	} else if doc.Version != ExportVersion {
+		return fmt.Errorf("unsupported meta export version: %d", doc.Version)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+	if err := doc.apply(data); err != nil {
+		return err
+	}
+	return c.commit("ImportMeta", data)
+}
+
+func newExportDocument(data *Data) *exportDocument {
+	doc := &exportDocument{
+		Version:   ExportVersion,
+		Databases: make([]exportDatabase, 0, len(data.Databases)),
+		Users:     make([]exportUser, 0, len(data.Users)),
+	}
+
+	for _, di := range data.Databases {
+		db := exportDatabase{
+			Name:                   di.Name,
+			DefaultRetentionPolicy: di.DefaultRetentionPolicy,
+			RetentionPolicies:      make([]exportRetentionPolicy, 0, len(di.RetentionPolicies)),
+			ContinuousQueries:      make([]exportContinuousQuery, 0, len(di.ContinuousQueries)),
+			Labels:                 cloneLabels(di.Labels),
+		}
+		if !di.ExpiresAt.IsZero() {
+			t := di.ExpiresAt
+			db.ExpiresAt = &t
+		}
+		for _, rpi := range di.RetentionPolicies {
+			rp := exportRetentionPolicy{
+				Name:               rpi.Name,
+				Duration:           rpi.Duration.String(),
+				ShardGroupDuration: rpi.ShardGroupDuration.String(),
+				ReplicaN:           rpi.ReplicaN,
+				Subscriptions:      make([]exportSubscription, 0, len(rpi.Subscriptions)),
+				Labels:             cloneLabels(rpi.Labels),
+			}
+			for _, si := range rpi.Subscriptions {
+				rp.Subscriptions = append(rp.Subscriptions, exportSubscription{
+					Name:         si.Name,
+					Mode:         si.Mode,
+					Destinations: append([]string(nil), si.Destinations...),
+				})
+			}
+			db.RetentionPolicies = append(db.RetentionPolicies, rp)
+		}
+		for _, cqi := range di.ContinuousQueries {
+			db.ContinuousQueries = append(db.ContinuousQueries, exportContinuousQuery{Name: cqi.Name, Query: cqi.Query})
+		}
+		doc.Databases = append(doc.Databases, db)
+	}
+
+	for _, ui := range data.Users {
+		u := exportUser{Name: ui.Name, Hash: ui.Hash, Admin: ui.Admin}
+		if len(ui.Privileges) > 0 {
+			u.Privileges = make(map[string]string, len(ui.Privileges))
+			for db, p := range ui.Privileges {
+				u.Privileges[db] = p.String()
+			}
+		}
+		doc.Users = append(doc.Users, u)
+	}
+	return doc
+}
+
+// apply creates the contents of the document in data. Errors name the
+// database, retention policy, continuous query or user that failed.
+func (doc *exportDocument) apply(data *Data) error {
+	for _, db := range doc.Databases {
+		if err := db.apply(data); err != nil {
+			return fmt.Errorf("import database %q: %s", db.Name, err)
+		}
+	}
+
+	for _, u := range doc.Users {
+		if err := u.apply(data); err != nil {
+			return fmt.Errorf("import user %q: %s", u.Name, err)
+		}
+	}
+	return nil
+}
+
+// apply creates the database and everything on it in data.
+func (db *exportDatabase) apply(data *Data) error {
+	if data.Database(db.Name) != nil {
+		return ErrDatabaseExists
+	} else if err := data.CreateDatabase(db.Name); err != nil {
+		return err
+	} else if err := data.SetDatabaseLabels(db.Name, db.Labels); err != nil {
+		return err
+	} else if db.ExpiresAt != nil {
+		if err := data.SetDatabaseExpiry(db.Name, *db.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	for _, rp := range db.RetentionPolicies {
+		if err := rp.apply(data, db.Name, rp.Name == db.DefaultRetentionPolicy); err != nil {
+			return fmt.Errorf("retention policy %q: %s", rp.Name, err)
+		}
+	}
+
+	for _, cq := range db.ContinuousQueries {
+		if err := data.CreateContinuousQuery(db.Name, cq.Name, cq.Query); err != nil {
+			return fmt.Errorf("continuous query %q: %s", cq.Name, err)
+		}
+	}
+	return nil
+}
+
+// apply creates the retention policy and its subscriptions on database in data.
+func (rp *exportRetentionPolicy) apply(data *Data, database string, makeDefault bool) error {
+	rpi := &RetentionPolicyInfo{Name: rp.Name, ReplicaN: rp.ReplicaN}
+
+	var err error
+	if rpi.Duration, err = time.ParseDuration(rp.Duration); err != nil {
+		return err
+	} else if rpi.ShardGroupDuration, err = time.ParseDuration(rp.ShardGroupDuration); err != nil {
+		return err
+	} else if rpi.Duration != 0 && rpi.Duration < MinRetentionPolicyDuration {
+		return ErrRetentionPolicyDurationTooLow
+	}
+
+	if err := data.CreateRetentionPolicy(database, rpi, makeDefault); err != nil {
+		return err
+	} else if err := data.SetRetentionPolicyLabels(database, rp.Name, rp.Labels); err != nil {
+		return err
+	}
+
+	for _, s := range rp.Subscriptions {
+		if err := data.CreateSubscription(database, rp.Name, s.Name, s.Mode, s.Destinations); err != nil {
+			return fmt.Errorf("subscription %q: %s", s.Name, err)
+		}
+	}
+	return nil
+}
+
+// apply creates the user and its privileges in data.
+func (u *exportUser) apply(data *Data) error {
+	if err := data.CreateUser(u.Name, u.Hash, u.Admin); err != nil {
+		return err
+	}
+	for db, s := range u.Privileges {
+		p, err := parsePrivilege(s)
+		if err != nil {
+			return err
+		} else if err := data.SetPrivilege(u.Name, db, p); err != nil {
+			return fmt.Errorf("privilege on database %q: %s", db, err)
+		}
+	}
+	return nil
+}
+
+// parsePrivilege returns the privilege whose string representation is s.
+func parsePrivilege(s string) (influxql.Privilege, error) {
+	for _, p := range []influxql.Privilege{influxql.NoPrivileges, influxql.ReadPrivilege, influxql.WritePrivilege, influxql.AllPrivileges} {
+		if p.String() == s {
+			return p, nil
+		}
+	}
+	return influxql.NoPrivileges, fmt.Errorf("invalid privilege: %q", s)
+}
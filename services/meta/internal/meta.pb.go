@@ -52,6 +52,7 @@ It has these top-level messages:
 	Response
 	SetMetaNodeCommand
 	DropShardCommand
+	Label
 */
 package meta
 
@@ -321,6 +322,8 @@ type DatabaseInfo struct {
 	DefaultRetentionPolicy *string                `protobuf:"bytes,2,req,name=DefaultRetentionPolicy" json:"DefaultRetentionPolicy,omitempty"`
 	RetentionPolicies      []*RetentionPolicyInfo `protobuf:"bytes,3,rep,name=RetentionPolicies" json:"RetentionPolicies,omitempty"`
 	ContinuousQueries      []*ContinuousQueryInfo `protobuf:"bytes,4,rep,name=ContinuousQueries" json:"ContinuousQueries,omitempty"`
+	Labels                 []*Label               `protobuf:"bytes,5,rep,name=Labels" json:"Labels,omitempty"`
+	ExpiresAt              *int64                 `protobuf:"varint,6,opt,name=ExpiresAt" json:"ExpiresAt,omitempty"`
 	XXX_unrecognized       []byte                 `json:"-"`
 }
 
@@ -357,6 +360,20 @@ func (m *DatabaseInfo) GetContinuousQueries() []*ContinuousQueryInfo {
 	return nil
 }
 
+func (m *DatabaseInfo) GetLabels() []*Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *DatabaseInfo) GetExpiresAt() int64 {
+	if m != nil && m.ExpiresAt != nil {
+		return *m.ExpiresAt
+	}
+	return 0
+}
+
 type RetentionPolicySpec struct {
 	Name               *string `protobuf:"bytes,1,opt,name=Name" json:"Name,omitempty"`
 	Duration           *int64  `protobuf:"varint,2,opt,name=Duration" json:"Duration,omitempty"`
@@ -405,6 +422,7 @@ type RetentionPolicyInfo struct {
 	ReplicaN           *uint32             `protobuf:"varint,4,req,name=ReplicaN" json:"ReplicaN,omitempty"`
 	ShardGroups        []*ShardGroupInfo   `protobuf:"bytes,5,rep,name=ShardGroups" json:"ShardGroups,omitempty"`
 	Subscriptions      []*SubscriptionInfo `protobuf:"bytes,6,rep,name=Subscriptions" json:"Subscriptions,omitempty"`
+	Labels             []*Label            `protobuf:"bytes,7,rep,name=Labels" json:"Labels,omitempty"`
 	XXX_unrecognized   []byte              `json:"-"`
 }
 
@@ -455,6 +473,13 @@ func (m *RetentionPolicyInfo) GetSubscriptions() []*SubscriptionInfo {
 	return nil
 }
 
+func (m *RetentionPolicyInfo) GetLabels() []*Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type ShardGroupInfo struct {
 	ID               *uint64      `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
 	StartTime        *int64       `protobuf:"varint,2,req,name=StartTime" json:"StartTime,omitempty"`
@@ -1815,6 +1840,31 @@ var E_DropShardCommand_Command = &proto.ExtensionDesc{
 	Filename:      "internal/meta.proto",
 }
 
+type Label struct {
+	Key              *string `protobuf:"bytes,1,req,name=Key" json:"Key,omitempty"`
+	Value            *string `protobuf:"bytes,2,req,name=Value" json:"Value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Label) Reset()                    { *m = Label{} }
+func (m *Label) String() string            { return proto.CompactTextString(m) }
+func (*Label) ProtoMessage()               {}
+func (*Label) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{43} }
+
+func (m *Label) GetKey() string {
+	if m != nil && m.Key != nil {
+		return *m.Key
+	}
+	return ""
+}
+
+func (m *Label) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Data)(nil), "meta.Data")
 	proto.RegisterType((*NodeInfo)(nil), "meta.NodeInfo")
@@ -1859,6 +1909,7 @@ func init() {
 	proto.RegisterType((*Response)(nil), "meta.Response")
 	proto.RegisterType((*SetMetaNodeCommand)(nil), "meta.SetMetaNodeCommand")
 	proto.RegisterType((*DropShardCommand)(nil), "meta.DropShardCommand")
+	proto.RegisterType((*Label)(nil), "meta.Label")
 	proto.RegisterEnum("meta.Command_Type", Command_Type_name, Command_Type_value)
 	proto.RegisterExtension(E_CreateNodeCommand_Command)
 	proto.RegisterExtension(E_DeleteNodeCommand_Command)
@@ -1894,118 +1945,122 @@ func init() {
 func init() { proto.RegisterFile("internal/meta.proto", fileDescriptorMeta) }
 
 var fileDescriptorMeta = []byte{
-	// 1808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6f, 0x1c, 0xc7,
-	0x11, 0x46, 0xcf, 0x3e, 0xb8, 0x5b, 0x7c, 0xaa, 0xf9, 0x1a, 0x4a, 0x14, 0xb3, 0x18, 0x08, 0xca,
-	0x22, 0x08, 0x98, 0x60, 0x03, 0xe8, 0x94, 0x97, 0xc4, 0x95, 0xc4, 0x85, 0xc0, 0x47, 0x66, 0xa9,
-	0x6b, 0x80, 0x11, 0xb7, 0x25, 0x6e, 0xb2, 0x3b, 0xb3, 0x99, 0x99, 0x95, 0xc4, 0x28, 0x4c, 0x18,
-	0x5f, 0x7c, 0xb5, 0x61, 0x18, 0x3e, 0xe8, 0x66, 0x1f, 0x7c, 0x34, 0x0c, 0x03, 0x06, 0x0c, 0x9f,
-	0x7c, 0xf7, 0x1f, 0xf0, 0x7f, 0xb0, 0xcf, 0xbe, 0x1a, 0xdd, 0x3d, 0x3d, 0xdd, 0x33, 0xd3, 0x3d,
-	0x24, 0x65, 0xf9, 0x36, 0x5d, 0x55, 0xdd, 0xf5, 0x55, 0x75, 0x75, 0x75, 0x55, 0x0f, 0x2c, 0x0f,
-	0xfd, 0x98, 0x84, 0xbe, 0x37, 0xfa, 0xdd, 0x98, 0xc4, 0xde, 0xf6, 0x24, 0x0c, 0xe2, 0x00, 0x57,
-	0xe9, 0xb7, 0xf3, 0x5e, 0x05, 0xaa, 0x5d, 0x2f, 0xf6, 0x30, 0x86, 0xea, 0x11, 0x09, 0xc7, 0x36,
-	0x6a, 0x59, 0xed, 0xaa, 0xcb, 0xbe, 0xf1, 0x0a, 0xd4, 0x7a, 0xfe, 0x80, 0xbc, 0xb4, 0x2d, 0x46,
-	0xe4, 0x03, 0xbc, 0x09, 0xcd, 0x9d, 0xd1, 0x34, 0x8a, 0x49, 0xd8, 0xeb, 0xda, 0x15, 0xc6, 0x91,
-	0x04, 0x7c, 0x0b, 0x6a, 0xfb, 0xc1, 0x80, 0x44, 0x76, 0xb5, 0x55, 0x69, 0xcf, 0x76, 0x16, 0xb6,
-	0x99, 0x4a, 0x4a, 0xea, 0xf9, 0x4f, 0x03, 0x97, 0x33, 0xf1, 0xef, 0xa1, 0x49, 0xb5, 0x3e, 0xf1,
-	0x22, 0x12, 0xd9, 0x35, 0x26, 0x89, 0xb9, 0xa4, 0x20, 0x33, 0x69, 0x29, 0x44, 0xd7, 0x7d, 0x1c,
-	0x91, 0x30, 0xb2, 0xeb, 0xea, 0xba, 0x94, 0xc4, 0xd7, 0x65, 0x4c, 0x8a, 0x6d, 0xcf, 0x7b, 0xc9,
-	0xb4, 0x75, 0xed, 0x19, 0x8e, 0x2d, 0x25, 0xe0, 0x36, 0x2c, 0xee, 0x79, 0x2f, 0xfb, 0x27, 0x5e,
-	0x38, 0x78, 0x18, 0x06, 0xd3, 0x49, 0xaf, 0x6b, 0x37, 0x98, 0x4c, 0x9e, 0x8c, 0xb7, 0x00, 0x04,
-	0xa9, 0xd7, 0xb5, 0x9b, 0x4c, 0x48, 0xa1, 0xe0, 0xdf, 0x72, 0xfc, 0xdc, 0x52, 0xd0, 0x5a, 0x2a,
-	0x05, 0xa8, 0xf4, 0x1e, 0x11, 0xd2, 0xb3, 0x7a, 0xe9, 0x54, 0xc0, 0xd9, 0x85, 0x86, 0x20, 0xe3,
-	0x05, 0xb0, 0x7a, 0xdd, 0x64, 0x4f, 0xac, 0x5e, 0x97, 0xee, 0xd2, 0x6e, 0x10, 0xc5, 0x6c, 0x43,
-	0x9a, 0x2e, 0xfb, 0xc6, 0x36, 0xcc, 0x1c, 0xed, 0x1c, 0x32, 0x72, 0xa5, 0x85, 0xda, 0x4d, 0x57,
-	0x0c, 0x9d, 0xef, 0x11, 0xcc, 0xa9, 0xfe, 0xa4, 0xd3, 0xf7, 0xbd, 0x31, 0x61, 0x0b, 0x36, 0x5d,
-	0xf6, 0x8d, 0xef, 0xc0, 0x5a, 0x97, 0x3c, 0xf5, 0xa6, 0xa3, 0xd8, 0x25, 0x31, 0xf1, 0xe3, 0x61,
-	0xe0, 0x1f, 0x06, 0xa3, 0xe1, 0xf1, 0x69, 0xa2, 0xc4, 0xc0, 0xc5, 0x0f, 0xe1, 0x5a, 0x96, 0x34,
-	0x24, 0x91, 0x5d, 0x61, 0xc6, 0x6d, 0x70, 0xe3, 0x72, 0x33, 0x98, 0x9d, 0xc5, 0x39, 0x74, 0xa1,
-	0x9d, 0xc0, 0x8f, 0x87, 0xfe, 0x34, 0x98, 0x46, 0x7f, 0x9b, 0x92, 0x70, 0x98, 0x46, 0x4f, 0xb2,
-	0x50, 0x96, 0x9d, 0x2c, 0x54, 0x98, 0xe3, 0xbc, 0x8f, 0x60, 0x39, 0xa7, 0xb3, 0x3f, 0x21, 0xc7,
-	0x8a, 0xd5, 0x28, 0xb5, 0xfa, 0x3a, 0x34, 0xba, 0xd3, 0xd0, 0xa3, 0x92, 0xb6, 0xd5, 0x42, 0xed,
-	0x8a, 0x9b, 0x8e, 0xf1, 0x36, 0x60, 0x19, 0x0c, 0xa9, 0x54, 0x85, 0x49, 0x69, 0x38, 0x74, 0x2d,
-	0x97, 0x4c, 0x46, 0xc3, 0x63, 0x6f, 0xdf, 0xae, 0xb6, 0x50, 0x7b, 0xde, 0x4d, 0xc7, 0xce, 0xbb,
-	0x56, 0x01, 0x93, 0x71, 0x27, 0xb2, 0x98, 0xac, 0x4b, 0x61, 0xb2, 0x2e, 0x85, 0xc9, 0x52, 0x31,
-	0xe1, 0x3b, 0x30, 0x2b, 0x67, 0x88, 0xe3, 0xb7, 0xc2, 0x5d, 0xad, 0x9c, 0x02, 0xea, 0x65, 0x55,
-	0x10, 0xff, 0x11, 0xe6, 0xfb, 0xd3, 0x27, 0xd1, 0x71, 0x38, 0x9c, 0x50, 0x1d, 0xe2, 0x28, 0xae,
-	0x25, 0x33, 0x15, 0x16, 0x9b, 0x9b, 0x15, 0x76, 0xbe, 0x41, 0xb0, 0x90, 0x5d, 0xbd, 0x10, 0xdd,
-	0x9b, 0xd0, 0xec, 0xc7, 0x5e, 0x18, 0x1f, 0x0d, 0xc7, 0x24, 0xf1, 0x80, 0x24, 0xd0, 0x38, 0xbf,
-	0xef, 0x0f, 0x18, 0x8f, 0xdb, 0x2d, 0x86, 0x74, 0x5e, 0x97, 0x8c, 0x48, 0x4c, 0x06, 0x77, 0x63,
-	0x66, 0x6d, 0xc5, 0x95, 0x04, 0xfc, 0x6b, 0xa8, 0x33, 0xbd, 0xc2, 0xd2, 0x45, 0xc5, 0x52, 0x06,
-	0x34, 0x61, 0xe3, 0x16, 0xcc, 0x1e, 0x85, 0x53, 0xff, 0xd8, 0xe3, 0x0b, 0xd5, 0xd9, 0x86, 0xab,
-	0x24, 0x87, 0x40, 0x33, 0x9d, 0x56, 0x40, 0xbf, 0x05, 0x8d, 0x83, 0x17, 0x3e, 0x4d, 0x82, 0x91,
-	0x6d, 0xb5, 0x2a, 0xed, 0xea, 0x3d, 0xcb, 0x46, 0x6e, 0x4a, 0xc3, 0x6d, 0xa8, 0xb3, 0x6f, 0x71,
-	0x4a, 0x96, 0x14, 0x1c, 0x8c, 0xe1, 0x26, 0x7c, 0xe7, 0xef, 0xb0, 0x94, 0xf7, 0xa6, 0x36, 0x60,
-	0x30, 0x54, 0xf7, 0x82, 0x01, 0x11, 0xd9, 0x80, 0x7e, 0x63, 0x07, 0xe6, 0xba, 0x24, 0x8a, 0x87,
-	0xbe, 0xc7, 0xf7, 0x88, 0xea, 0x6a, 0xba, 0x19, 0x9a, 0x73, 0x0b, 0x40, 0x6a, 0xc5, 0x6b, 0x50,
-	0x4f, 0x12, 0x26, 0xb7, 0x25, 0x19, 0x39, 0x7f, 0x81, 0x65, 0xcd, 0xc1, 0xd3, 0x02, 0x59, 0x81,
-	0x1a, 0x13, 0x48, 0x90, 0xf0, 0x81, 0x73, 0x06, 0x0d, 0x91, 0x9f, 0x4d, 0xf0, 0x77, 0xbd, 0xe8,
-	0x24, 0x4d, 0x66, 0x5e, 0x74, 0x42, 0x57, 0xba, 0x3b, 0x18, 0x0f, 0x79, 0x68, 0x37, 0x5c, 0x3e,
-	0xc0, 0x7f, 0x00, 0x38, 0x0c, 0x87, 0xcf, 0x87, 0x23, 0xf2, 0x2c, 0xcd, 0x0d, 0xcb, 0xf2, 0x06,
-	0x48, 0x79, 0xae, 0x22, 0xe6, 0xf4, 0x60, 0x3e, 0xc3, 0x64, 0xe7, 0x2b, 0xc9, 0x86, 0x09, 0x8e,
-	0x74, 0x4c, 0x43, 0x28, 0x15, 0x64, 0x80, 0x6a, 0xae, 0x24, 0x38, 0xdf, 0xd5, 0x61, 0x66, 0x27,
-	0x18, 0x8f, 0x3d, 0x7f, 0x80, 0x6f, 0x43, 0x35, 0x3e, 0x9d, 0xf0, 0x15, 0x16, 0xc4, 0xad, 0x95,
-	0x30, 0xb7, 0x8f, 0x4e, 0x27, 0xc4, 0x65, 0x7c, 0xe7, 0x75, 0x1d, 0xaa, 0x74, 0x88, 0x57, 0xe1,
-	0xda, 0x4e, 0x48, 0xbc, 0x98, 0x50, 0xbf, 0x26, 0x82, 0x4b, 0x88, 0x92, 0x79, 0x8c, 0xaa, 0x64,
-	0x0b, 0x6f, 0xc0, 0x2a, 0x97, 0x16, 0xd0, 0x04, 0xab, 0x82, 0xd7, 0x61, 0xb9, 0x1b, 0x06, 0x93,
-	0x3c, 0xa3, 0x8a, 0x5b, 0xb0, 0xc9, 0xe7, 0xe4, 0x32, 0x8d, 0x90, 0xa8, 0xe1, 0x2d, 0xb8, 0x4e,
-	0xa7, 0x1a, 0xf8, 0x75, 0x7c, 0x0b, 0x5a, 0x7d, 0x12, 0xeb, 0x33, 0xbd, 0x90, 0x9a, 0xa1, 0x7a,
-	0x1e, 0x4f, 0x06, 0x66, 0x3d, 0x0d, 0x7c, 0x03, 0xd6, 0x39, 0x12, 0x79, 0xd2, 0x05, 0xb3, 0x49,
-	0x99, 0xdc, 0xe2, 0x22, 0x13, 0xa4, 0x0d, 0xb9, 0x98, 0x13, 0x12, 0xb3, 0xc2, 0x06, 0x03, 0x7f,
-	0x4e, 0xfa, 0x99, 0xee, 0xba, 0x20, 0xcf, 0xe3, 0x65, 0x58, 0xa4, 0xd3, 0x54, 0xe2, 0x02, 0x95,
-	0xe5, 0x96, 0xa8, 0xe4, 0x45, 0xea, 0xe1, 0x3e, 0x89, 0xd3, 0x7d, 0x17, 0x8c, 0x25, 0x8c, 0x61,
-	0x81, 0xfa, 0xc7, 0x8b, 0x3d, 0x41, 0xbb, 0x86, 0x37, 0xc1, 0xee, 0x93, 0x98, 0x05, 0x68, 0x61,
-	0x06, 0x96, 0x1a, 0xd4, 0xed, 0x5d, 0xc6, 0x37, 0x61, 0x23, 0x71, 0x90, 0x72, 0xc0, 0x05, 0x7b,
-	0x95, 0xb9, 0x28, 0x0c, 0x26, 0x3a, 0xe6, 0x1a, 0x5d, 0xd2, 0x25, 0xe3, 0xe0, 0x39, 0x39, 0x24,
-	0x12, 0xf4, 0xba, 0x8c, 0x18, 0x51, 0x42, 0x08, 0x96, 0x9d, 0x0d, 0x26, 0x95, 0xb5, 0x41, 0x59,
-	0x1c, 0x5f, 0x9e, 0x75, 0x9d, 0xb2, 0xf8, 0x3e, 0xe5, 0x17, 0xbc, 0x21, 0x59, 0xf9, 0x59, 0x9b,
-	0x78, 0x0d, 0x70, 0x9f, 0xc4, 0xf9, 0x29, 0x37, 0xf1, 0x0a, 0x2c, 0x31, 0x93, 0xe8, 0x9e, 0x0b,
-	0xea, 0xd6, 0x6f, 0x1a, 0x8d, 0xc1, 0xd2, 0xf9, 0xf9, 0xf9, 0xb9, 0xe5, 0x9c, 0x69, 0x8e, 0x47,
-	0x5a, 0xe7, 0x20, 0xa5, 0xce, 0xc1, 0x50, 0x75, 0x3d, 0x7f, 0x90, 0x14, 0xa3, 0xec, 0xbb, 0xf3,
-	0x57, 0x98, 0x39, 0x4e, 0xa6, 0xcc, 0x67, 0x4e, 0xa2, 0x4d, 0x5a, 0xa8, 0x3d, 0xdb, 0x59, 0x4f,
-	0x88, 0x79, 0x05, 0xae, 0x98, 0xe6, 0xbc, 0xd2, 0x1c, 0xc3, 0x42, 0x6a, 0x5f, 0x81, 0xda, 0x83,
-	0x20, 0x3c, 0xe6, 0x99, 0xa1, 0xe1, 0xf2, 0x41, 0x89, 0xf2, 0xa7, 0xaa, 0xf2, 0xc2, 0xf2, 0x52,
-	0xf9, 0x97, 0xc8, 0x70, 0xda, 0xb5, 0xf9, 0x72, 0x07, 0x16, 0x8b, 0x25, 0x1a, 0x2a, 0xaf, 0xb7,
-	0xf2, 0x33, 0x3a, 0x5d, 0x23, 0xe8, 0x67, 0x6c, 0xad, 0x1b, 0xaa, 0xc7, 0x72, 0xa8, 0x24, 0xf0,
-	0xb1, 0x36, 0x15, 0xe9, 0x50, 0x77, 0xee, 0x19, 0x15, 0x9e, 0xa8, 0xe0, 0x35, 0xcb, 0x49, 0x75,
-	0xdf, 0xa2, 0xf2, 0x0c, 0x57, 0x9a, 0xda, 0xb5, 0x6e, 0xb3, 0xae, 0xe8, 0xb6, 0x47, 0x46, 0x2b,
-	0x86, 0xcc, 0x0a, 0x47, 0x75, 0x9b, 0x1e, 0xa4, 0x34, 0xe7, 0x23, 0x54, 0x96, 0x8e, 0x4b, 0x8d,
-	0x11, 0x1e, 0xb6, 0x14, 0x0f, 0xf7, 0x8c, 0xd8, 0xfe, 0xc1, 0xb0, 0xb5, 0xa4, 0x87, 0x2f, 0x42,
-	0xf6, 0x09, 0xba, 0xf8, 0x22, 0xb8, 0x32, 0xbe, 0x03, 0x23, 0xbe, 0x7f, 0x32, 0x7c, 0xb7, 0x93,
-	0x42, 0xe8, 0x02, 0xbd, 0x12, 0xe5, 0x0f, 0xa8, 0xfc, 0x22, 0xba, 0x2a, 0x42, 0x5a, 0x5a, 0xee,
-	0x93, 0x17, 0x8c, 0x9c, 0xb4, 0x50, 0xc9, 0x30, 0x53, 0x93, 0x57, 0x73, 0x7d, 0x82, 0x5a, 0x63,
-	0xd7, 0xb2, 0x75, 0x7f, 0x49, 0xbc, 0x8c, 0xd4, 0x78, 0x29, 0xb3, 0x42, 0xda, 0xfb, 0x05, 0x32,
-	0x5e, 0xab, 0xa5, 0xa6, 0xae, 0x41, 0x3d, 0xd3, 0xca, 0x25, 0x23, 0x5a, 0xec, 0xd0, 0xba, 0x39,
-	0x8a, 0xbd, 0xf1, 0x24, 0xa9, 0xa5, 0x25, 0xa1, 0xf3, 0xc0, 0x08, 0x7d, 0xcc, 0xa0, 0xdf, 0x54,
-	0x43, 0xbd, 0x00, 0x48, 0xa2, 0xfe, 0x0a, 0x19, 0xef, 0xfb, 0x37, 0x42, 0xed, 0xc0, 0x5c, 0xa6,
-	0x75, 0xe7, 0x4f, 0x0f, 0x19, 0x5a, 0x09, 0x76, 0x5f, 0xc5, 0x6e, 0x80, 0x25, 0xb1, 0x7f, 0x8e,
-	0xca, 0xcb, 0x91, 0x2b, 0x47, 0x58, 0x5a, 0x21, 0x57, 0x94, 0x0a, 0xb9, 0x24, 0x4a, 0x82, 0x62,
-	0x56, 0xd1, 0x23, 0x29, 0x66, 0x95, 0xb7, 0x83, 0xb8, 0x24, 0xab, 0x4c, 0xf2, 0x59, 0xe5, 0x22,
-	0x64, 0x1f, 0x20, 0x4d, 0x69, 0xf6, 0xf3, 0x5a, 0x82, 0x92, 0xcb, 0xf7, 0x5f, 0xc5, 0x9b, 0x5f,
-	0x51, 0x2b, 0x51, 0x91, 0x42, 0x61, 0xa8, 0xbd, 0xbf, 0xfe, 0x6c, 0x54, 0x14, 0x32, 0x45, 0xab,
-	0xd2, 0x0f, 0x5a, 0x35, 0x67, 0x9a, 0x52, 0xf3, 0xb2, 0xb6, 0x97, 0x58, 0x19, 0xa9, 0x56, 0x16,
-	0x14, 0x48, 0xf5, 0x9f, 0x21, 0x6d, 0x4d, 0x4b, 0xc3, 0x81, 0xca, 0xfb, 0x12, 0x45, 0x3a, 0xce,
-	0x84, 0x8a, 0x55, 0xd6, 0x28, 0x55, 0x72, 0x8d, 0x52, 0xc9, 0x65, 0x1f, 0xab, 0x97, 0xbd, 0x06,
-	0x90, 0x44, 0x1c, 0xe4, 0x6b, 0x6d, 0xbc, 0xc5, 0xdf, 0x28, 0x19, 0xce, 0xd9, 0x0e, 0xc8, 0x87,
-	0x42, 0x97, 0xd1, 0x3b, 0x7f, 0x32, 0x6a, 0x9d, 0x32, 0xad, 0x2b, 0xf2, 0x82, 0x91, 0xab, 0x4a,
-	0x85, 0x1f, 0x22, 0x73, 0x25, 0x5f, 0xea, 0xa7, 0x34, 0x32, 0x2d, 0x35, 0x32, 0x1f, 0x1a, 0xd1,
-	0x3c, 0x67, 0x68, 0xb6, 0x52, 0x34, 0x5a, 0x8d, 0x12, 0xd7, 0xa9, 0xa6, 0x85, 0xb8, 0xcc, 0x8b,
-	0x60, 0x49, 0xd4, 0xbc, 0x28, 0x46, 0x8d, 0xb6, 0x30, 0xfd, 0x11, 0x95, 0xf4, 0x29, 0xc6, 0xc7,
-	0x2b, 0x53, 0xcc, 0xb4, 0x8b, 0x15, 0x18, 0x4f, 0x83, 0x79, 0x72, 0xfa, 0xa2, 0x51, 0x2d, 0x79,
-	0xd1, 0xa8, 0x15, 0x5f, 0x34, 0x3a, 0xbb, 0x46, 0x8b, 0x4f, 0x99, 0xc5, 0xbf, 0xca, 0xdc, 0x59,
-	0x45, 0x93, 0xa4, 0xe5, 0x5f, 0x23, 0x63, 0x0b, 0xf6, 0xcb, 0xd9, 0x5d, 0x72, 0x6f, 0xfd, 0x3b,
-	0x73, 0x6f, 0xe9, 0x81, 0x65, 0x42, 0xa6, 0xd0, 0x22, 0xa6, 0x21, 0x83, 0x64, 0xc8, 0xdc, 0x1d,
-	0x0c, 0x42, 0x11, 0x32, 0xf4, 0xbb, 0x24, 0x64, 0x5e, 0xa9, 0x21, 0x53, 0x58, 0x5c, 0xaa, 0xfe,
-	0x14, 0x19, 0xfa, 0x50, 0xea, 0xa2, 0xdd, 0xa3, 0xa3, 0x43, 0xa6, 0x33, 0x39, 0x42, 0x62, 0x9c,
-	0x3c, 0x5e, 0x2b, 0x70, 0xc4, 0x30, 0x6d, 0xf7, 0x2a, 0x4a, 0xbb, 0x67, 0x6e, 0x5e, 0xfe, 0x53,
-	0x6c, 0x5e, 0x72, 0x30, 0x32, 0xd7, 0x91, 0xbe, 0x2d, 0x7e, 0x33, 0xa4, 0x25, 0xa8, 0xce, 0xf4,
-	0x2d, 0x95, 0x16, 0xd5, 0x6b, 0x64, 0xe8, 0xc8, 0xaf, 0xfe, 0x13, 0xc0, 0x52, 0x7e, 0x02, 0x94,
-	0xa0, 0xfb, 0xaf, 0x8a, 0x4e, 0xab, 0x5a, 0x6d, 0xf8, 0xf4, 0x6f, 0x02, 0x79, 0x70, 0x25, 0xea,
-	0xfe, 0xa7, 0xaa, 0xd3, 0x2e, 0x26, 0xd5, 0xf9, 0x86, 0x77, 0x86, 0x82, 0xba, 0xfb, 0x46, 0x75,
-	0xe7, 0xa8, 0xa8, 0xcf, 0x68, 0xde, 0x03, 0x5a, 0xca, 0x47, 0x93, 0xc0, 0x8f, 0x08, 0x55, 0x71,
-	0xf0, 0x88, 0xa9, 0x68, 0xb8, 0xd6, 0xc1, 0x23, 0x9a, 0xe5, 0xef, 0x87, 0x61, 0x10, 0xb2, 0x66,
-	0xbb, 0xe9, 0xf2, 0x81, 0xfc, 0x37, 0x56, 0x61, 0xe7, 0x8a, 0x0f, 0x9c, 0x8f, 0x91, 0xee, 0x15,
-	0xe4, 0x2d, 0x9e, 0x00, 0xf3, 0x05, 0xfb, 0x7f, 0x6e, 0xaf, 0x9d, 0xde, 0x2e, 0x46, 0xe7, 0x0e,
-	0x8a, 0x2f, 0x32, 0x05, 0xbf, 0x9a, 0xf3, 0xc1, 0x3b, 0x5c, 0xcf, 0x9a, 0x92, 0x91, 0x94, 0x85,
-	0x52, 0x2d, 0x3f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x04, 0x86, 0xd9, 0x75, 0x1c, 0x00, 0x00,
+	// 1867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6f, 0xdc, 0x46,
+	0x12, 0x06, 0x39, 0x0f, 0xcd, 0xd4, 0xe8, 0xe5, 0xd6, 0x8b, 0xb2, 0x65, 0xed, 0x80, 0x6b, 0x78,
+	0x07, 0x8b, 0x85, 0xbc, 0x98, 0x05, 0x7c, 0xda, 0x97, 0xac, 0x91, 0xad, 0x81, 0x56, 0x8f, 0xe5,
+	0xc8, 0x7b, 0x5c, 0x80, 0xd6, 0xb4, 0xad, 0xd9, 0x9d, 0x21, 0x67, 0x49, 0x8e, 0x2d, 0xad, 0x57,
+	0x1b, 0x25, 0xbf, 0x20, 0x41, 0x10, 0xe4, 0xe0, 0x5b, 0x72, 0xc8, 0x25, 0x80, 0x11, 0x04, 0x08,
+	0x10, 0xe4, 0x94, 0x7b, 0xfe, 0x40, 0x7e, 0x44, 0xce, 0xb9, 0x06, 0xdd, 0xcd, 0x66, 0x37, 0xc9,
+	0x6e, 0x4a, 0x72, 0x9c, 0x1b, 0xbb, 0xaa, 0xba, 0xeb, 0xab, 0xea, 0xea, 0xea, 0xaa, 0x26, 0x2c,
+	0x0c, 0xbc, 0x08, 0x07, 0x9e, 0x3b, 0xbc, 0x37, 0xc2, 0x91, 0xbb, 0x31, 0x0e, 0xfc, 0xc8, 0x47,
+	0x65, 0xf2, 0x6d, 0xbf, 0x5f, 0x82, 0x72, 0xc7, 0x8d, 0x5c, 0x84, 0xa0, 0x7c, 0x84, 0x83, 0x91,
+	0x65, 0x34, 0xcd, 0x56, 0xd9, 0xa1, 0xdf, 0x68, 0x11, 0x2a, 0x5d, 0xaf, 0x8f, 0x4f, 0x2d, 0x93,
+	0x12, 0xd9, 0x00, 0xad, 0x41, 0x7d, 0x6b, 0x38, 0x09, 0x23, 0x1c, 0x74, 0x3b, 0x56, 0x89, 0x72,
+	0x04, 0x01, 0xdd, 0x81, 0xca, 0xbe, 0xdf, 0xc7, 0xa1, 0x55, 0x6e, 0x96, 0x5a, 0x8d, 0xf6, 0xec,
+	0x06, 0x55, 0x49, 0x48, 0x5d, 0xef, 0xa9, 0xef, 0x30, 0x26, 0xfa, 0x3d, 0xd4, 0x89, 0xd6, 0x27,
+	0x6e, 0x88, 0x43, 0xab, 0x42, 0x25, 0x11, 0x93, 0xe4, 0x64, 0x2a, 0x2d, 0x84, 0xc8, 0xba, 0x8f,
+	0x43, 0x1c, 0x84, 0x56, 0x55, 0x5e, 0x97, 0x90, 0xd8, 0xba, 0x94, 0x49, 0xb0, 0xed, 0xb9, 0xa7,
+	0x54, 0x5b, 0xc7, 0x9a, 0x62, 0xd8, 0x12, 0x02, 0x6a, 0xc1, 0xdc, 0x9e, 0x7b, 0xda, 0x3b, 0x71,
+	0x83, 0xfe, 0xa3, 0xc0, 0x9f, 0x8c, 0xbb, 0x1d, 0xab, 0x46, 0x65, 0xb2, 0x64, 0xb4, 0x0e, 0xc0,
+	0x49, 0xdd, 0x8e, 0x55, 0xa7, 0x42, 0x12, 0x05, 0xfd, 0x8e, 0xe1, 0x67, 0x96, 0x82, 0xd2, 0x52,
+	0x21, 0x40, 0xa4, 0xf7, 0x30, 0x97, 0x6e, 0xa8, 0xa5, 0x13, 0x01, 0x7b, 0x07, 0x6a, 0x9c, 0x8c,
+	0x66, 0xc1, 0xec, 0x76, 0xe2, 0x3d, 0x31, 0xbb, 0x1d, 0xb2, 0x4b, 0x3b, 0x7e, 0x18, 0xd1, 0x0d,
+	0xa9, 0x3b, 0xf4, 0x1b, 0x59, 0x30, 0x75, 0xb4, 0x75, 0x48, 0xc9, 0xa5, 0xa6, 0xd1, 0xaa, 0x3b,
+	0x7c, 0x68, 0xbf, 0x36, 0x61, 0x5a, 0xf6, 0x27, 0x99, 0xbe, 0xef, 0x8e, 0x30, 0x5d, 0xb0, 0xee,
+	0xd0, 0x6f, 0x74, 0x1f, 0x96, 0x3b, 0xf8, 0xa9, 0x3b, 0x19, 0x46, 0x0e, 0x8e, 0xb0, 0x17, 0x0d,
+	0x7c, 0xef, 0xd0, 0x1f, 0x0e, 0x8e, 0xcf, 0x62, 0x25, 0x1a, 0x2e, 0x7a, 0x04, 0x37, 0xd2, 0xa4,
+	0x01, 0x0e, 0xad, 0x12, 0x35, 0x6e, 0x95, 0x19, 0x97, 0x99, 0x41, 0xed, 0xcc, 0xcf, 0x21, 0x0b,
+	0x6d, 0xf9, 0x5e, 0x34, 0xf0, 0x26, 0xfe, 0x24, 0xfc, 0xfb, 0x04, 0x07, 0x83, 0x24, 0x7a, 0xe2,
+	0x85, 0xd2, 0xec, 0x78, 0xa1, 0xdc, 0x1c, 0xf4, 0x6b, 0xa8, 0xfe, 0xcd, 0x7d, 0x82, 0x87, 0x3c,
+	0xa2, 0x1a, 0x6c, 0x36, 0xa5, 0x39, 0x31, 0x8b, 0x44, 0xc8, 0xf6, 0xe9, 0x78, 0x10, 0xe0, 0x70,
+	0x33, 0xb2, 0xaa, 0x4d, 0xa3, 0x55, 0x72, 0x04, 0xc1, 0xfe, 0xc0, 0x80, 0x85, 0x0c, 0xec, 0xde,
+	0x18, 0x1f, 0x4b, 0x8e, 0x33, 0x12, 0xc7, 0xdd, 0x84, 0x5a, 0x67, 0x12, 0xb8, 0x44, 0xd2, 0x32,
+	0xe9, 0x42, 0xc9, 0x18, 0x6d, 0x00, 0x12, 0xf1, 0x94, 0x48, 0x95, 0xa8, 0x94, 0x82, 0x43, 0xd6,
+	0x72, 0xf0, 0x78, 0x38, 0x38, 0x76, 0xf7, 0xad, 0x72, 0xd3, 0x68, 0xcd, 0x38, 0xc9, 0xd8, 0xfe,
+	0xdc, 0xcc, 0x61, 0xd2, 0x6e, 0x66, 0x1a, 0x93, 0x79, 0x25, 0x4c, 0xe6, 0x95, 0x30, 0x99, 0x32,
+	0x26, 0x74, 0x1f, 0x1a, 0x62, 0x06, 0xf7, 0xf7, 0x22, 0xf3, 0xb7, 0x74, 0x90, 0xc8, 0x46, 0xc9,
+	0x82, 0xe8, 0x8f, 0x30, 0xd3, 0x9b, 0x3c, 0x09, 0x8f, 0x83, 0xc1, 0x98, 0xe8, 0xe0, 0xa7, 0x79,
+	0x39, 0x9e, 0x29, 0xb1, 0xe8, 0xdc, 0xb4, 0xb0, 0xb4, 0xc1, 0x53, 0xda, 0x0d, 0xb6, 0xbf, 0x35,
+	0x60, 0x36, 0x0d, 0x21, 0x77, 0x8a, 0xd6, 0xa0, 0xde, 0x8b, 0xdc, 0x20, 0x3a, 0x1a, 0x8c, 0x70,
+	0xec, 0x26, 0x41, 0x20, 0xe7, 0x69, 0xdb, 0xeb, 0x53, 0x1e, 0x73, 0x0e, 0x1f, 0x92, 0x79, 0x1d,
+	0x3c, 0xc4, 0x11, 0xee, 0x6f, 0x46, 0xd4, 0x25, 0x25, 0x47, 0x10, 0xd0, 0x6f, 0xa0, 0x4a, 0xf5,
+	0x72, 0x77, 0xcc, 0x49, 0xee, 0xa0, 0xd6, 0xc4, 0x6c, 0xd4, 0x84, 0xc6, 0x51, 0x30, 0xf1, 0x8e,
+	0x5d, 0xb6, 0x10, 0x0b, 0x42, 0x99, 0x64, 0x63, 0xa8, 0x27, 0xd3, 0x72, 0xe8, 0xd7, 0xa1, 0x76,
+	0xf0, 0xc2, 0x23, 0xc9, 0x36, 0xb4, 0xcc, 0x66, 0xa9, 0x55, 0x7e, 0x60, 0x5a, 0x86, 0x93, 0xd0,
+	0x50, 0x0b, 0xaa, 0xf4, 0x9b, 0x9f, 0xc6, 0x79, 0x09, 0x07, 0x65, 0x38, 0x31, 0xdf, 0xfe, 0x27,
+	0xcc, 0x67, 0x5d, 0xae, 0x8c, 0x2a, 0x04, 0xe5, 0x3d, 0xbf, 0x8f, 0x79, 0xd6, 0x21, 0xdf, 0xc8,
+	0x86, 0xe9, 0x0e, 0x0e, 0xa3, 0x81, 0xe7, 0xb2, 0x8d, 0x24, 0xba, 0xea, 0x4e, 0x8a, 0x66, 0xdf,
+	0x01, 0x10, 0x5a, 0xd1, 0x32, 0x54, 0xe3, 0xc4, 0xcc, 0x6c, 0x89, 0x47, 0xf6, 0x5f, 0x60, 0x41,
+	0x71, 0xc0, 0x95, 0x40, 0x16, 0xa1, 0x42, 0x05, 0x62, 0x24, 0x6c, 0x60, 0x9f, 0x43, 0x8d, 0xdf,
+	0x03, 0x3a, 0xf8, 0x3b, 0x6e, 0x78, 0x92, 0x24, 0x4d, 0x37, 0x3c, 0x21, 0x2b, 0x6d, 0xf6, 0x47,
+	0x03, 0x16, 0xff, 0x35, 0x87, 0x0d, 0xd0, 0x1f, 0x00, 0x0e, 0x83, 0xc1, 0xf3, 0xc1, 0x10, 0x3f,
+	0x4b, 0x72, 0xd0, 0x82, 0xb8, 0x69, 0x12, 0x9e, 0x23, 0x89, 0xd9, 0x5d, 0x98, 0x49, 0x31, 0xe9,
+	0x21, 0x8c, 0xb3, 0x6e, 0x8c, 0x23, 0x19, 0x93, 0x10, 0x4a, 0x04, 0x29, 0xa0, 0x8a, 0x23, 0x08,
+	0xf6, 0xf7, 0x55, 0x98, 0xda, 0xf2, 0x47, 0x23, 0xd7, 0xeb, 0xa3, 0xbb, 0x50, 0x8e, 0xce, 0xc6,
+	0x6c, 0x85, 0x59, 0x7e, 0x3b, 0xc6, 0xcc, 0x8d, 0xa3, 0xb3, 0x31, 0x76, 0x28, 0xdf, 0x7e, 0x55,
+	0x85, 0x32, 0x19, 0xa2, 0x25, 0xb8, 0xb1, 0x15, 0x60, 0x37, 0xc2, 0xc4, 0xaf, 0xb1, 0xe0, 0xbc,
+	0x41, 0xc8, 0x2c, 0x46, 0x65, 0xb2, 0x89, 0x56, 0x61, 0x89, 0x49, 0x73, 0x68, 0x9c, 0x55, 0x42,
+	0x2b, 0xb0, 0xd0, 0x09, 0xfc, 0x71, 0x96, 0x51, 0x46, 0x4d, 0x58, 0x63, 0x73, 0x32, 0xe9, 0x88,
+	0x4b, 0x54, 0xd0, 0x3a, 0xdc, 0x24, 0x53, 0x35, 0xfc, 0x2a, 0xba, 0x03, 0xcd, 0x1e, 0x8e, 0xd4,
+	0x37, 0x0a, 0x97, 0x9a, 0x22, 0x7a, 0x1e, 0x8f, 0xfb, 0x7a, 0x3d, 0x35, 0x74, 0x0b, 0x56, 0x18,
+	0x12, 0x71, 0xd2, 0x39, 0xb3, 0x4e, 0x98, 0xcc, 0xe2, 0x3c, 0x13, 0x84, 0x0d, 0x99, 0x98, 0xe3,
+	0x12, 0x0d, 0x6e, 0x83, 0x86, 0x3f, 0x2d, 0xfc, 0x4c, 0x76, 0x9d, 0x93, 0x67, 0xd0, 0x02, 0xcc,
+	0x91, 0x69, 0x32, 0x71, 0x96, 0xc8, 0x32, 0x4b, 0x64, 0xf2, 0x1c, 0xf1, 0x70, 0x0f, 0x47, 0xc9,
+	0xbe, 0x73, 0xc6, 0x3c, 0x42, 0x30, 0x4b, 0xfc, 0xe3, 0x46, 0x2e, 0xa7, 0xdd, 0x40, 0x6b, 0x60,
+	0xf5, 0x70, 0x44, 0x03, 0x34, 0x37, 0x03, 0x09, 0x0d, 0xf2, 0xf6, 0x2e, 0xa0, 0xdb, 0xb0, 0x1a,
+	0x3b, 0x48, 0x3a, 0xe0, 0x9c, 0xbd, 0x44, 0x5d, 0x14, 0xf8, 0x63, 0x15, 0x73, 0x99, 0x2c, 0xe9,
+	0xe0, 0x91, 0xff, 0x1c, 0x1f, 0x62, 0x01, 0x7a, 0x45, 0x44, 0x0c, 0x2f, 0x55, 0x38, 0xcb, 0x4a,
+	0x07, 0x93, 0xcc, 0x5a, 0x25, 0x2c, 0x86, 0x2f, 0xcb, 0xba, 0x49, 0x58, 0x6c, 0x9f, 0xb2, 0x0b,
+	0xde, 0x12, 0xac, 0xec, 0xac, 0x35, 0xb4, 0x0c, 0xa8, 0x87, 0xa3, 0xec, 0x94, 0xdb, 0x68, 0x11,
+	0xe6, 0xa9, 0x49, 0x64, 0xcf, 0x39, 0x75, 0xfd, 0xb7, 0xb5, 0x5a, 0x7f, 0xfe, 0xe2, 0xe2, 0xe2,
+	0xc2, 0xb4, 0xcf, 0x15, 0xc7, 0x23, 0xa9, 0xa7, 0x0c, 0xa9, 0x9e, 0x42, 0x50, 0x76, 0x5c, 0xaf,
+	0x1f, 0x17, 0xbd, 0xf4, 0xbb, 0xfd, 0x57, 0x98, 0x3a, 0x8e, 0xa7, 0xcc, 0xa4, 0x4e, 0xa2, 0x85,
+	0x9b, 0x46, 0xab, 0xd1, 0x5e, 0x89, 0x89, 0x59, 0x05, 0x0e, 0x9f, 0x66, 0xbf, 0x54, 0x1c, 0xc3,
+	0x5c, 0x6a, 0x5f, 0x84, 0xca, 0x43, 0x3f, 0x38, 0x66, 0x99, 0xa1, 0xe6, 0xb0, 0x41, 0x81, 0xf2,
+	0xa7, 0xb2, 0xf2, 0xdc, 0xf2, 0x42, 0xf9, 0x57, 0x86, 0xe6, 0xb4, 0x2b, 0xf3, 0xe5, 0x16, 0xcc,
+	0xe5, 0x4b, 0x41, 0xa3, 0xb8, 0xae, 0xcb, 0xce, 0x68, 0x77, 0xb4, 0xa0, 0x9f, 0xd1, 0xb5, 0x6e,
+	0xc9, 0x1e, 0xcb, 0xa0, 0x12, 0xc0, 0x47, 0xca, 0x54, 0xa4, 0x42, 0xdd, 0x7e, 0xa0, 0x55, 0x78,
+	0x22, 0x83, 0x57, 0x2c, 0x27, 0xd4, 0x7d, 0x67, 0x14, 0x67, 0xb8, 0xc2, 0xd4, 0xae, 0x74, 0x9b,
+	0x79, 0x4d, 0xb7, 0xed, 0x6a, 0xad, 0x18, 0x50, 0x2b, 0x6c, 0xd9, 0x6d, 0x6a, 0x90, 0xc2, 0x9c,
+	0x8f, 0x8d, 0xa2, 0x74, 0x5c, 0x68, 0x0c, 0xf7, 0xb0, 0x29, 0x79, 0xb8, 0xab, 0xc5, 0xf6, 0x2f,
+	0x8a, 0xad, 0x29, 0x3c, 0x7c, 0x19, 0xb2, 0x4f, 0x8d, 0xcb, 0x2f, 0x82, 0x6b, 0xe3, 0x3b, 0xd0,
+	0xe2, 0xfb, 0x37, 0xc5, 0x77, 0x97, 0x11, 0x2f, 0xd3, 0x2b, 0x50, 0xfe, 0x60, 0x14, 0x5f, 0x44,
+	0xd7, 0x45, 0x48, 0x4a, 0xcb, 0x7d, 0xfc, 0x82, 0x92, 0xe3, 0x56, 0x2d, 0x1e, 0xa6, 0x0a, 0xf7,
+	0x72, 0xa6, 0x99, 0x90, 0x0b, 0xf1, 0x4a, 0xba, 0x39, 0x28, 0x88, 0x97, 0xa1, 0x1c, 0x2f, 0x45,
+	0x56, 0x08, 0x7b, 0xbf, 0x34, 0xb4, 0xd7, 0x6a, 0xa1, 0xa9, 0xcb, 0x50, 0x4d, 0xb5, 0x8c, 0xf1,
+	0x88, 0x14, 0x3b, 0xa4, 0x6e, 0x0e, 0x23, 0x77, 0x34, 0x8e, 0x6b, 0x69, 0x41, 0x68, 0x3f, 0xd4,
+	0x42, 0x1f, 0x51, 0xe8, 0xb7, 0xe5, 0x50, 0xcf, 0x01, 0x12, 0xa8, 0xbf, 0x36, 0xb4, 0xf7, 0xfd,
+	0x1b, 0xa1, 0xb6, 0x61, 0x3a, 0xf5, 0x44, 0xc0, 0x9e, 0x38, 0x52, 0xb4, 0x02, 0xec, 0x9e, 0x8c,
+	0x5d, 0x03, 0x4b, 0x60, 0xff, 0xc2, 0x28, 0x2e, 0x47, 0xae, 0x1d, 0x61, 0x49, 0x85, 0x5c, 0x92,
+	0x2a, 0xe4, 0x82, 0x28, 0xf1, 0xf3, 0x59, 0x45, 0x8d, 0x24, 0x9f, 0x55, 0xde, 0x0e, 0xe2, 0x82,
+	0xac, 0x32, 0xce, 0x66, 0x95, 0xcb, 0x90, 0x7d, 0x68, 0x28, 0x4a, 0xb3, 0x9f, 0xd7, 0x12, 0x14,
+	0x5c, 0xbe, 0xff, 0xc9, 0xdf, 0xfc, 0x92, 0x5a, 0x81, 0x0a, 0xe7, 0x0a, 0x43, 0xe5, 0xfd, 0xf5,
+	0x67, 0xad, 0xa2, 0x80, 0x2a, 0x5a, 0x12, 0x7e, 0x50, 0xaa, 0x39, 0x57, 0x94, 0x9a, 0x57, 0xb5,
+	0xbd, 0xc0, 0xca, 0x50, 0xb6, 0x32, 0xa7, 0x40, 0xa8, 0x7f, 0x6d, 0x28, 0x6b, 0x5a, 0x12, 0x0e,
+	0x44, 0xde, 0x13, 0x28, 0x92, 0x71, 0x2a, 0x54, 0xcc, 0xa2, 0x46, 0xa9, 0x94, 0x69, 0x94, 0x0a,
+	0x2e, 0xfb, 0x48, 0xbe, 0xec, 0x15, 0x80, 0x04, 0x62, 0x3f, 0x5b, 0x6b, 0xa3, 0x75, 0xf6, 0x16,
+	0x4a, 0x71, 0x36, 0xda, 0x20, 0x1e, 0x24, 0x1d, 0x4a, 0x6f, 0xff, 0x49, 0xab, 0x75, 0xd2, 0x34,
+	0xa4, 0x07, 0x90, 0xd4, 0xaa, 0x42, 0xe1, 0x47, 0x86, 0xbe, 0x92, 0x2f, 0xf4, 0x53, 0x12, 0x99,
+	0xa6, 0x1c, 0x99, 0x8f, 0xb4, 0x68, 0x9e, 0x53, 0x34, 0xeb, 0x09, 0x1a, 0xa5, 0x46, 0x81, 0xeb,
+	0x4c, 0xd1, 0x42, 0x5c, 0xe5, 0xe5, 0xb1, 0x20, 0x6a, 0x5e, 0xe4, 0xa3, 0x46, 0x59, 0x98, 0xfe,
+	0x68, 0x14, 0xf4, 0x29, 0xda, 0x17, 0x2e, 0x5d, 0xcc, 0xb4, 0xf2, 0x15, 0x18, 0x4b, 0x83, 0x59,
+	0x72, 0xf2, 0xa2, 0x51, 0x2e, 0x78, 0xd1, 0xa8, 0xe4, 0x5f, 0x34, 0xda, 0x3b, 0x5a, 0x8b, 0xcf,
+	0xa8, 0xc5, 0xbf, 0x4a, 0xdd, 0x59, 0x79, 0x93, 0x84, 0xe5, 0xdf, 0x18, 0xda, 0x16, 0xec, 0x97,
+	0xb3, 0xbb, 0xe0, 0xde, 0xfa, 0x6f, 0xea, 0xde, 0x52, 0x03, 0x4b, 0x85, 0x4c, 0xae, 0x45, 0x4c,
+	0x42, 0xc6, 0x10, 0x21, 0xb3, 0xd9, 0xef, 0x07, 0x3c, 0x64, 0xc8, 0x77, 0x41, 0xc8, 0xbc, 0x94,
+	0x43, 0x26, 0xb7, 0xb8, 0x50, 0xfd, 0x99, 0xa1, 0xe9, 0x43, 0x89, 0x8b, 0x76, 0x8e, 0x8e, 0x0e,
+	0xa9, 0xce, 0xf8, 0x08, 0xf1, 0x71, 0xfc, 0x48, 0x2e, 0xc1, 0xe1, 0xc3, 0xa4, 0xdd, 0x2b, 0x49,
+	0xed, 0x9e, 0xbe, 0x79, 0xf9, 0x5f, 0xbe, 0x79, 0xc9, 0xc0, 0x48, 0x5d, 0x47, 0xea, 0xb6, 0xf8,
+	0xcd, 0x90, 0x16, 0xa0, 0x3a, 0x57, 0xb7, 0x54, 0x4a, 0x54, 0xaf, 0x0c, 0x4d, 0x47, 0x7e, 0xfd,
+	0x9f, 0x0d, 0xa6, 0xf4, 0xb3, 0xa1, 0x00, 0xdd, 0xff, 0x65, 0x74, 0x4a, 0xd5, 0x72, 0xc3, 0xa7,
+	0x7e, 0x13, 0xc8, 0x82, 0x2b, 0x50, 0xf7, 0x8e, 0xac, 0x4e, 0xb9, 0x98, 0x50, 0xe7, 0x69, 0xde,
+	0x19, 0x72, 0xea, 0xb6, 0xb5, 0xea, 0x2e, 0x8c, 0xbc, 0x3e, 0xad, 0x79, 0x0f, 0x49, 0x29, 0x1f,
+	0x8e, 0x7d, 0x2f, 0xc4, 0x44, 0xc5, 0xc1, 0x2e, 0x55, 0x51, 0x73, 0xcc, 0x83, 0x5d, 0x92, 0xe5,
+	0xb7, 0x83, 0xc0, 0x0f, 0x68, 0xb3, 0x5d, 0x77, 0xd8, 0x40, 0xfc, 0x83, 0x2b, 0xd1, 0x73, 0xc5,
+	0x06, 0xf6, 0x27, 0x86, 0xea, 0x15, 0xe4, 0x2d, 0x9e, 0x00, 0xfd, 0x05, 0xfb, 0x2e, 0xb3, 0xd7,
+	0x4a, 0x6e, 0x17, 0xad, 0x73, 0xfb, 0xf9, 0x17, 0x99, 0x9c, 0x5f, 0xf5, 0xf9, 0xe0, 0x3d, 0xa6,
+	0x67, 0x59, 0xca, 0x48, 0xd2, 0x42, 0x42, 0xcb, 0x3d, 0xa8, 0xd0, 0x97, 0x7f, 0x34, 0x0f, 0xa5,
+	0x5d, 0x7c, 0x16, 0xdb, 0x4d, 0x3e, 0x89, 0xef, 0xfe, 0xe1, 0x0e, 0x27, 0x3c, 0x61, 0xb2, 0xc1,
+	0x4f, 0x03, 0x00, 0x10, 0xcd, 0x26, 0xf0, 0x0e, 0x1d, 0x00, 0x00,
 }
@@ -0,0 +1,412 @@
+package meta
+
+import "sort"
+
+// DatabaseEventType identifies the kind of change a DatabaseEvent describes.
+type DatabaseEventType int
+
+const (
+	// DatabaseCreated is delivered when the watched database comes into
+	// existence, for retention policy watches too. The event lists the
+	// policies the database was created with, which may be none, for example
+	// when retention policy auto-creation is disabled. Policies added later
+	// are delivered as DatabaseUpdated.
+	DatabaseCreated DatabaseEventType = iota + 1
+
+	// DatabaseUpdated is delivered when the watched part of an existing
+	// database changes.
+	DatabaseUpdated
+
+	// DatabaseDropped is delivered when the watched database is removed.
+	DatabaseDropped
+)
+
+// String returns a string representation of the event type.
+func (t DatabaseEventType) String() string {
+	switch t {
+	case DatabaseCreated:
+		return "created"
+	case DatabaseUpdated:
+		return "updated"
+	case DatabaseDropped:
+		return "dropped"
+	}
+	return "unknown"
+}
+
+// DatabaseEvent is delivered on a Watch when the watched part of a
+// database's meta data changes.
+type DatabaseEvent struct {
+	// Index is the meta data index at which the change was committed.
+	Index uint64
+
+	// Type is the kind of change.
+	Type DatabaseEventType
+
+	// Database is the name of the watched database.
+	Database string
+
+	// RetentionPolicies lists, in sorted order, the names of the retention
+	// policies that were created, dropped or updated, including the old
+	// and new default when the default changes. It is only set on events
+	// from WatchRetentionPolicies.
+	RetentionPolicies []string
+
+	// Info is a deep copy of the database after the change, or nil if the
+	// database was dropped.
+	Info *DatabaseInfo
+}
+
+// merge folds a newer event into e, an event that was never received, so
+// the receiver sees the combined change.
+func (e DatabaseEvent) merge(next DatabaseEvent) DatabaseEvent {
+	if e.Type == DatabaseCreated && next.Type == DatabaseUpdated {
+		next.Type = DatabaseCreated
+	} else if e.Type == DatabaseDropped && next.Type == DatabaseCreated {
+		next.Type = DatabaseUpdated
+	}
+
+	if e.RetentionPolicies != nil {
+		next.RetentionPolicies = mergeNames(e.RetentionPolicies, next.RetentionPolicies)
+	}
+	return next
+}
+
+// watchKind determines which part of a database a Watch observes.
+type watchKind int
+
+const (
+	watchDatabase watchKind = iota
+	watchRetentionPolicies
+)
+
+// Watch delivers DatabaseEvents for a single database. Events are
+// coalesced: if the receiver falls behind, pending events are merged into
+// one carrying the latest Info, the union of the affected retention
+// policies and the overall kind of change.
+type Watch struct {
+	// C receives events. It is closed when the watch or the client is closed.
+	C <-chan DatabaseEvent
+
+	c        chan DatabaseEvent
+	database string
+	kind     watchKind
+	client   *Client
+}
+
+// Close stops the delivery of events and closes C.
+func (w *Watch) Close() {
+	w.client.mu.Lock()
+	defer w.client.mu.Unlock()
+	w.client.unwatch(w)
+}
+
+// notify sends ev on the watch channel, merging it with any event that has
+// not been received yet. This method assumes the client mutex is locked.
+func (w *Watch) notify(ev DatabaseEvent) {
+	select {
+	case pending := <-w.c:
+		ev = pending.merge(ev)
+	default:
+	}
+	w.c <- ev
+}
+
+// WatchDatabase returns a Watch that receives an event whenever anything
+// in the named database changes, including its creation and removal.
+func (c *Client) WatchDatabase(name string) *Watch {
+	return c.watch(name, watchDatabase)
+}
+
+// WatchRetentionPolicies returns a Watch that receives an event whenever a
+// retention policy on the named database is created, dropped or updated,
+// or the default retention policy changes. Shard group, subscription,
+// label and continuous query changes are ignored.
+func (c *Client) WatchRetentionPolicies(database string) *Watch {
+	return c.watch(database, watchRetentionPolicies)
+}
+
+func (c *Client) watch(database string, kind watchKind) *Watch {
+	ch := make(chan DatabaseEvent, 1)
+	w := &Watch{
+		C:        ch,
+		c:        ch,
+		database: database,
+		kind:     kind,
+		client:   c,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.closing:
+		close(w.c)
+		return w
+	default:
+	}
+
+	ws := c.watches[database]
+	if ws == nil {
+		ws = make(map[*Watch]struct{})
+		c.watches[database] = ws
+	}
+	ws[w] = struct{}{}
+	return w
+}
+
+// unwatch removes w from the client and closes its channel.
+// This method assumes c's mutex is already locked.
+func (c *Client) unwatch(w *Watch) {
+	ws := c.watches[w.database]
+	if _, ok := ws[w]; !ok {
+		return
+	}
+
+	delete(ws, w)
+	if len(ws) == 0 {
+		delete(c.watches, w.database)
+	}
+	close(w.c)
+}
+
+// notifyWatches delivers events to the watches whose part of the meta data
+// differs between prev and curr. Each watched database is compared at most
+// once per kind of watch. This method assumes c's mutex is already locked.
+func (c *Client) notifyWatches(prev, curr *Data) {
+	for name, ws := range c.watches {
+		d := databaseDiff{prev: prev.Database(name), curr: curr.Database(name)}
+		if d.prev == nil && d.curr == nil {
+			continue
+		}
+
+		for w := range ws {
+			ev, ok := d.event(w.kind)
+			if !ok {
+				continue
+			}
+
+			ev.Index = curr.Index
+			ev.Database = name
+			if d.curr != nil {
+				info := d.curr.clone()
+				ev.Info = &info
+			}
+			w.notify(ev)
+		}
+	}
+}
+
+// databaseDiff lazily computes and caches the changes to a single database.
+type databaseDiff struct {
+	prev, curr *DatabaseInfo
+
+	db, rp *DatabaseEvent
+}
+
+// event returns the event for a watch of the given kind, and false if the
+// watched part of the database did not change.
+func (d *databaseDiff) event(kind watchKind) (DatabaseEvent, bool) {
+	switch kind {
+	case watchRetentionPolicies:
+		if d.rp == nil {
+			d.rp = d.retentionPoliciesEvent()
+		}
+		return *d.rp, d.rp.Type != 0
+	default:
+		if d.db == nil {
+			d.db = d.databaseEvent()
+		}
+		return *d.db, d.db.Type != 0
+	}
+}
+
+func (d *databaseDiff) databaseEvent() *DatabaseEvent {
+	switch {
+	case d.prev == nil:
+		return &DatabaseEvent{Type: DatabaseCreated}
+	case d.curr == nil:
+		return &DatabaseEvent{Type: DatabaseDropped}
+	case !databaseInfoEqual(d.prev, d.curr):
+		return &DatabaseEvent{Type: DatabaseUpdated}
+	}
+	return &DatabaseEvent{}
+}
+
+func (d *databaseDiff) retentionPoliciesEvent() *DatabaseEvent {
+	switch {
+	case d.prev == nil:
+		return &DatabaseEvent{Type: DatabaseCreated, RetentionPolicies: retentionPolicyNames(d.curr)}
+	case d.curr == nil:
+		return &DatabaseEvent{Type: DatabaseDropped, RetentionPolicies: retentionPolicyNames(d.prev)}
+	}
+
+	if names := changedRetentionPolicies(d.prev, d.curr); len(names) > 0 {
+		return &DatabaseEvent{Type: DatabaseUpdated, RetentionPolicies: names}
+	}
+	return &DatabaseEvent{}
+}
+
+// retentionPolicyNames returns the sorted names of the retention policies on di.
+func retentionPolicyNames(di *DatabaseInfo) []string {
+	names := make([]string, 0, len(di.RetentionPolicies))
+	for i := range di.RetentionPolicies {
+		names = append(names, di.RetentionPolicies[i].Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// changedRetentionPolicies returns the sorted names of the retention policies
+// that were created, dropped or redefined between prev and curr, plus the old
+// and new default retention policy if the default changed.
+func changedRetentionPolicies(prev, curr *DatabaseInfo) []string {
+	set := make(map[string]struct{})
+	for i := range curr.RetentionPolicies {
+		n := &curr.RetentionPolicies[i]
+		if p := prev.RetentionPolicy(n.Name); p == nil || !retentionPolicyDefinitionEqual(p, n) {
+			set[n.Name] = struct{}{}
+		}
+	}
+	for i := range prev.RetentionPolicies {
+		if curr.RetentionPolicy(prev.RetentionPolicies[i].Name) == nil {
+			set[prev.RetentionPolicies[i].Name] = struct{}{}
+		}
+	}
+	if prev.DefaultRetentionPolicy != curr.DefaultRetentionPolicy {
+		for _, name := range []string{prev.DefaultRetentionPolicy, curr.DefaultRetentionPolicy} {
+			if name != "" {
+				set[name] = struct{}{}
+			}
+		}
+	}
+
+	if len(set) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// mergeNames returns the sorted union of two sorted name lists.
+func mergeNames(a, b []string) []string {
+	names := make([]string, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		switch {
+		case a[0] < b[0]:
+			names, a = append(names, a[0]), a[1:]
+		case a[0] > b[0]:
+			names, b = append(names, b[0]), b[1:]
+		default:
+			names, a, b = append(names, a[0]), a[1:], b[1:]
+		}
+	}
+	names = append(names, a...)
+	return append(names, b...)
+}
+
+// databaseInfoEqual returns true if a and b describe the same database.
+func databaseInfoEqual(a, b *DatabaseInfo) bool {
+	if a.Name != b.Name ||
+		a.DefaultRetentionPolicy != b.DefaultRetentionPolicy ||
+		len(a.RetentionPolicies) != len(b.RetentionPolicies) ||
+		len(a.ContinuousQueries) != len(b.ContinuousQueries) ||
+		!labelsEqual(a.Labels, b.Labels) ||
+		!a.ExpiresAt.Equal(b.ExpiresAt) {
+		return false
+	}
+
+	for i := range a.ContinuousQueries {
+		if a.ContinuousQueries[i] != b.ContinuousQueries[i] {
+			return false
+		}
+	}
+
+	for i := range a.RetentionPolicies {
+		if !retentionPolicyInfoEqual(&a.RetentionPolicies[i], &b.RetentionPolicies[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// retentionPolicyDefinitionEqual returns true if a and b have the same name
+// and settings, ignoring shard groups, subscriptions and labels.
+func retentionPolicyDefinitionEqual(a, b *RetentionPolicyInfo) bool {
+	return a.Name == b.Name &&
+		a.ReplicaN == b.ReplicaN &&
+		a.Duration == b.Duration &&
+		a.ShardGroupDuration == b.ShardGroupDuration
+}
+
+// retentionPolicyInfoEqual returns true if a and b are identical, including
+// their shard groups, subscriptions and labels.
+func retentionPolicyInfoEqual(a, b *RetentionPolicyInfo) bool {
+	if !retentionPolicyDefinitionEqual(a, b) ||
+		len(a.ShardGroups) != len(b.ShardGroups) ||
+		len(a.Subscriptions) != len(b.Subscriptions) ||
+		!labelsEqual(a.Labels, b.Labels) {
+		return false
+	}
+
+	for i := range a.Subscriptions {
+		sa, sb := &a.Subscriptions[i], &b.Subscriptions[i]
+		if sa.Name != sb.Name || sa.Mode != sb.Mode || len(sa.Destinations) != len(sb.Destinations) {
+			return false
+		}
+		for j := range sa.Destinations {
+			if sa.Destinations[j] != sb.Destinations[j] {
+				return false
+			}
+		}
+	}
+
+	for i := range a.ShardGroups {
+		if !shardGroupInfoEqual(&a.ShardGroups[i], &b.ShardGroups[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// shardGroupInfoEqual returns true if a and b are identical, including their shards.
+func shardGroupInfoEqual(a, b *ShardGroupInfo) bool {
+	if a.ID != b.ID ||
+		!a.StartTime.Equal(b.StartTime) ||
+		!a.EndTime.Equal(b.EndTime) ||
+		!a.DeletedAt.Equal(b.DeletedAt) ||
+		!a.TruncatedAt.Equal(b.TruncatedAt) ||
+		len(a.Shards) != len(b.Shards) {
+		return false
+	}
+
+	for i := range a.Shards {
+		sa, sb := &a.Shards[i], &b.Shards[i]
+		if sa.ID != sb.ID || len(sa.Owners) != len(sb.Owners) {
+			return false
+		}
+		for j := range sa.Owners {
+			if sa.Owners[j] != sb.Owners[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// labelsEqual returns true if a and b contain the same labels.
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
@@ -16,10 +16,12 @@ type Service struct {
 		Databases() []meta.DatabaseInfo
 		DeleteShardGroup(database, policy string, id uint64) error
 		PruneShardGroups() error
+		DropDatabase(name string) error
 	}
 	TSDBStore interface {
 		ShardIDs() []uint64
 		DeleteShard(shardID uint64) error
+		DeleteDatabase(name string) error
 	}
 
 	config Config
@@ -93,8 +95,23 @@ func (s *Service) run() {
 			// Without the message, they may see the error message and assume they
 			// have to do it manually.
 			var retryNeeded bool
+			now := time.Now().UTC()
 			dbs := s.MetaClient.Databases()
 			for _, d := range dbs {
+				// Drop the whole database once it has expired. Its shards
+				// are removed along with it.
+				if !d.ExpiresAt.IsZero() && !now.Before(d.ExpiresAt) {
+					if err := s.dropDatabase(d.Name); err != nil {
+						log.Info("Failed to drop expired database",
+							logger.Database(d.Name),
+							zap.Error(err))
+						retryNeeded = true
+						continue
+					}
+					log.Info("Dropped expired database", logger.Database(d.Name))
+					continue
+				}
+
 				for _, r := range d.RetentionPolicies {
 					// Build list of already deleted shards.
 					for _, g := range r.DeletedShardGroups() {
@@ -104,7 +121,7 @@ func (s *Service) run() {
 					}
 
 					// Determine all shards that have expired and need to be deleted.
-					for _, g := range r.ExpiredShardGroups(time.Now().UTC()) {
+					for _, g := range r.ExpiredShardGroups(now) {
 						if err := s.MetaClient.DeleteShardGroup(d.Name, r.Name, g.ID); err != nil {
 							log.Info("Failed to delete shard group",
 								logger.Database(d.Name),
@@ -160,3 +177,12 @@ func (s *Service) run() {
 		}
 	}
 }
+
+// dropDatabase removes the local data for a database and then drops it from
+// the meta store, in the same order as DROP DATABASE.
+func (s *Service) dropDatabase(name string) error {
+	if err := s.TSDBStore.DeleteDatabase(name); err != nil {
+		return err
+	}
+	return s.MetaClient.DropDatabase(name)
+}
@@ -205,6 +205,85 @@ func TestService_CheckShards(t *testing.T) {
 	}
 }
 
+func TestService_CheckExpiredDatabases(t *testing.T) {
+	now := time.Now().UTC()
+
+	var mu sync.Mutex
+	data := []meta.DatabaseInfo{
+		{Name: "db0", ExpiresAt: now.Add(-time.Minute)},
+		{Name: "db1", ExpiresAt: now.Add(time.Hour)},
+		{Name: "db2"},
+	}
+
+	config := retention.NewConfig()
+	config.CheckInterval = toml.Duration(10 * time.Millisecond)
+	s := NewService(config)
+	s.MetaClient.DatabasesFn = func() []meta.DatabaseInfo {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]meta.DatabaseInfo(nil), data...)
+	}
+
+	var calls []string
+	s.TSDBStore.DeleteDatabaseFn = func(name string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, "DeleteDatabase "+name)
+		return nil
+	}
+	s.MetaClient.DropDatabaseFn = func(name string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, "DropDatabase "+name)
+		for i := range data {
+			if data[i].Name == name {
+				data = append(data[:i], data[i+1:]...)
+				break
+			}
+		}
+		return nil
+	}
+
+	done := make(chan struct{})
+	s.MetaClient.PruneShardGroupsFn = func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(calls) > 0 && done != nil {
+			close(done)
+			done = nil
+		}
+		return nil
+	}
+	s.TSDBStore.ShardIDsFn = func() []uint64 { return nil }
+
+	mu.Lock()
+	wait := done
+	mu.Unlock()
+
+	if err := s.Open(); err != nil {
+		t.Fatalf("unexpected open error: %s", err)
+	}
+	defer func() {
+		if err := s.Close(); err != nil {
+			t.Fatalf("unexpected close error: %s", err)
+		}
+	}()
+
+	timer := time.NewTimer(time.Second)
+	select {
+	case <-wait:
+		timer.Stop()
+	case <-timer.C:
+		t.Fatal("timeout waiting for expired database to be dropped")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got, want := calls, []string{"DeleteDatabase db0", "DropDatabase db0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected calls: got=%v want=%v", got, want)
+	}
+}
+
 // This reproduces https://github.com/influxdata/influxdb/issues/8819
 func TestService_8819_repro(t *testing.T) {
 	for i := 0; i < 1000; i++ {
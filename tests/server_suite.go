@@ -62,7 +62,7 @@ func init() {
 			&Query{
 				name:    "show database should succeed",
 				command: `SHOW DATABASES`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["db0"],["db0_r"]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name","labels"],"values":[["db0",""],["db0_r",""]]}]}]}`,
 			},
 			&Query{
 				name:    "create database should not error with existing database",
@@ -87,7 +87,7 @@ func init() {
 			&Query{
 				name:    "show database should succeed",
 				command: `SHOW DATABASES`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["db0"],["db0_r"],["db1"]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name","labels"],"values":[["db0",""],["db0_r",""],["db1",""]]}]}]}`,
 			},
 			&Query{
 				name:    "drop database db0 should succeed",
@@ -120,7 +120,7 @@ func init() {
 			&Query{
 				name:    "show database should have no results",
 				command: `SHOW DATABASES`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name","labels"]}]}]}`,
 			},
 			&Query{
 				name:    "create database with shard group duration should succeed",
@@ -456,7 +456,7 @@ func init() {
 			&Query{
 				name:    "show retention policy should succeed",
 				command: `SHOW RETENTION POLICIES ON db0`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default"],"values":[["rp0","1h0m0s","1h0m0s",1,false]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default","labels"],"values":[["rp0","1h0m0s","1h0m0s",1,false,""]]}]}]}`,
 			},
 			&Query{
 				name:    "alter retention policy should succeed",
@@ -467,12 +467,12 @@ func init() {
 			&Query{
 				name:    "show retention policy should have new altered information",
 				command: `SHOW RETENTION POLICIES ON db0`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default"],"values":[["rp0","2h0m0s","1h0m0s",3,true]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default","labels"],"values":[["rp0","2h0m0s","1h0m0s",3,true,""]]}]}]}`,
 			},
 			&Query{
 				name:    "show retention policy should still show policy",
 				command: `SHOW RETENTION POLICIES ON db0`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default"],"values":[["rp0","2h0m0s","1h0m0s",3,true]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default","labels"],"values":[["rp0","2h0m0s","1h0m0s",3,true,""]]}]}]}`,
 			},
 			&Query{
 				name:    "create a second non-default retention policy",
@@ -483,7 +483,7 @@ func init() {
 			&Query{
 				name:    "show retention policy should show both",
 				command: `SHOW RETENTION POLICIES ON db0`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default"],"values":[["rp0","2h0m0s","1h0m0s",3,true],["rp2","1h0m0s","1h0m0s",1,false]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default","labels"],"values":[["rp0","2h0m0s","1h0m0s",3,true,""],["rp2","1h0m0s","1h0m0s",1,false,""]]}]}]}`,
 			},
 			&Query{
 				name:    "dropping non-default retention policy succeed",
@@ -506,7 +506,7 @@ func init() {
 			&Query{
 				name:    "show retention policy should show both with custom shard",
 				command: `SHOW RETENTION POLICIES ON db0`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default"],"values":[["rp0","2h0m0s","1h0m0s",3,true],["rp3","1h0m0s","1h0m0s",1,false]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default","labels"],"values":[["rp0","2h0m0s","1h0m0s",3,true,""],["rp3","1h0m0s","1h0m0s",1,false,""]]}]}]}`,
 			},
 			&Query{
 				name:    "dropping non-default custom shard retention policy succeed",
@@ -517,7 +517,7 @@ func init() {
 			&Query{
 				name:    "show retention policy should show just default",
 				command: `SHOW RETENTION POLICIES ON db0`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default"],"values":[["rp0","2h0m0s","1h0m0s",3,true]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default","labels"],"values":[["rp0","2h0m0s","1h0m0s",3,true,""]]}]}]}`,
 			},
 			&Query{
 				name:    "Ensure retention policy with unacceptable retention cannot be created",
@@ -565,7 +565,7 @@ func init() {
 			&Query{
 				name:    "show retention policy: validate normalized shard group durations are working",
 				command: `SHOW RETENTION POLICIES ON db0`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default"],"values":[["rpinf","0s","168h0m0s",1,false],["rpzero","1h0m0s","1h0m0s",1,false],["rponesecond","2h0m0s","1h0m0s",1,false]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default","labels"],"values":[["rpinf","0s","168h0m0s",1,false,""],["rpzero","1h0m0s","1h0m0s",1,false,""],["rponesecond","2h0m0s","1h0m0s",1,false,""]]}]}]}`,
 			},
 		},
 	}
@@ -581,7 +581,7 @@ func init() {
 			&Query{
 				name:    "show retention policies should return auto-created policy",
 				command: `SHOW RETENTION POLICIES ON db0`,
-				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default"],"values":[["autogen","0s","168h0m0s",1,true]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default","labels"],"values":[["autogen","0s","168h0m0s",1,true,""]]}]}]}`,
 			},
 		},
 	}
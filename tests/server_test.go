@@ -381,7 +381,7 @@ func TestServer_ShowDatabases_NoAuth(t *testing.T) {
 			&Query{
 				name:    "show dbs",
 				command: "SHOW DATABASES",
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["db1"],["db2"]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name","labels"],"values":[["db1",""],["db2",""]]}]}]}`,
 			},
 		},
 	}
@@ -433,7 +433,7 @@ func TestServer_ShowDatabases_WithAuth(t *testing.T) {
 				name:    "show dbs as admin",
 				command: "SHOW DATABASES",
 				params:  adminParams,
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["dbR"],["dbW"]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name","labels"],"values":[["dbR",""],["dbW",""]]}]}]}`,
 			},
 			&Query{
 				name:    "create users",
@@ -445,19 +445,19 @@ func TestServer_ShowDatabases_WithAuth(t *testing.T) {
 				name:    "show dbs as reader",
 				command: "SHOW DATABASES",
 				params:  readerParams,
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["dbR"]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name","labels"],"values":[["dbR",""]]}]}]}`,
 			},
 			&Query{
 				name:    "show dbs as writer",
 				command: "SHOW DATABASES",
 				params:  writerParams,
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["dbW"]]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name","labels"],"values":[["dbW",""]]}]}]}`,
 			},
 			&Query{
 				name:    "show dbs as nobody",
 				command: "SHOW DATABASES",
 				params:  nobodyParams,
-				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"]}]}]}`,
+				exp:     `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name","labels"]}]}]}`,
 			},
 		},
 	}
@@ -799,7 +799,7 @@ func TestServer_Query_DefaultDBAndRP(t *testing.T) {
 		&Query{
 			name:    "default rp exists",
 			command: `show retention policies ON db0`,
-			exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default"],"values":[["autogen","0s","168h0m0s",1,false],["rp0","0s","168h0m0s",1,true]]}]}]}`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"columns":["name","duration","shardGroupDuration","replicaN","default","labels"],"values":[["autogen","0s","168h0m0s",1,false,""],["rp0","0s","168h0m0s",1,true,""]]}]}]}`,
 		},
 		&Query{
 			name:    "default rp",